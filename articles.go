@@ -1,7 +1,6 @@
 package dev
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/google/go-querystring/query"
@@ -51,11 +50,9 @@ type VideoArticle struct {
 	User                   *User  `json:"user"`
 }
 
-type ArticleFlareTag struct {
-	Name         string `json:"name"`
-	BGColorHEX   string `json:"bg_color_hex"`
-	TextColorHEX string `json:"text_color_hex"`
-}
+// ArticleFlareTag is the tag highlighted on an article, it shares
+// the tag model
+type ArticleFlareTag = Tag
 
 // There are some inconsistencies with regards to the article schema
 // returned when fetching articles and the schema returned when creating
@@ -66,17 +63,20 @@ type ArticleVariant struct {
 	TagList string   `json:"tag_list"`
 }
 
+// ArticleBodySchema is the payload of article create and update requests.
+// Series and OrganizationID are left out when unset, the article keeps
+// its current values on update
 type ArticleBodySchema struct {
 	Article struct {
 		Title          string   `json:"title"`
 		BodyMarkdown   string   `json:"body_markdown"`
 		Published      bool     `json:"published"`
-		Series         string   `json:"series"`
+		Series         string   `json:"series,omitempty"`
 		MainImage      string   `json:"main_image"`
 		CanonicalURL   string   `json:"canonical_url"`
 		Description    string   `json:"description"`
 		Tags           []string `json:"tags"`
-		OrganizationID int32    `json:"organization_id"`
+		OrganizationID int32    `json:"organization_id,omitempty"`
 	} `json:"article"`
 }
 
@@ -111,7 +111,7 @@ func (c *Client) GetPublishedArticles(q ArticleQueryParams) ([]Article, error) {
 
 	path := fmt.Sprintf("/articles?%s", query.Encode())
 
-	req, err := c.NewRequest(context.Background(), "GET", path, nil)
+	req, err := c.NewRequest(c.requestContext("GetPublishedArticles"), "GET", path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -124,6 +124,7 @@ func (c *Client) GetPublishedArticles(q ArticleQueryParams) ([]Article, error) {
 }
 
 // CreateArticle allows the client to create a new article
+// The payload is validated before it is sent
 // @filepath - article body can be set on the payload as a string
 //            or passed via the path to a markdown file
 func (c *Client) CreateArticle(payload ArticleBodySchema, filepath interface{}) (*ArticleVariant, error) {
@@ -138,7 +139,11 @@ func (c *Client) CreateArticle(payload ArticleBodySchema, filepath interface{})
 		payload.Article.BodyMarkdown = content
 	}
 
-	req, err := c.NewRequest(context.Background(), "POST", path, payload)
+	if err := payload.Validate(); err != nil {
+		return nil, err
+	}
+
+	req, err := c.NewRequest(c.requestContext("CreateArticle"), "POST", path, payload)
 	if err != nil {
 		return nil, err
 	}
@@ -164,7 +169,7 @@ func (c *Client) GetPublishedArticlesSorted(q ArticleQueryParams) ([]Article, er
 
 	path := fmt.Sprintf("/articles?%s", query.Encode())
 
-	req, err := c.NewRequest(context.Background(), "GET", path, nil)
+	req, err := c.NewRequest(c.requestContext("GetPublishedArticlesSorted"), "GET", path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -181,7 +186,7 @@ func (c *Client) GetPublishedArticlesSorted(q ArticleQueryParams) ([]Article, er
 func (c *Client) GetPublishedArticleByID(articleID string) (*ArticleVariant, error) {
 	path := fmt.Sprintf("/articles/%s", articleID)
 
-	req, err := c.NewRequest(context.Background(), "GET", path, nil)
+	req, err := c.NewRequest(c.requestContext("GetPublishedArticleByID"), "GET", path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -209,7 +214,11 @@ func (c *Client) UpdateArticle(articleID string, payload ArticleBodySchema, file
 		payload.Article.BodyMarkdown = content
 	}
 
-	req, err := c.NewRequest(context.Background(), "PUT", path, payload)
+	if err := payload.validate(false); err != nil {
+		return nil, err
+	}
+
+	req, err := c.NewRequest(c.requestContext("UpdateArticle"), "PUT", path, payload)
 	if err != nil {
 		return nil, err
 	}
@@ -228,7 +237,7 @@ func (c *Client) UpdateArticle(articleID string, payload ArticleBodySchema, file
 func (c *Client) GetPublishedArticleByPath(username, slug string) (*ArticleVariant, error) {
 	path := fmt.Sprintf("/articles/%s/%s", username, slug)
 
-	req, err := c.NewRequest(context.Background(), "GET", path, nil)
+	req, err := c.NewRequest(c.requestContext("GetPublishedArticleByPath"), "GET", path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -254,7 +263,7 @@ func (c *Client) GetUserArticles(q ArticleQueryParams) ([]Article, error) {
 
 	path := fmt.Sprintf("/articles/me/all?%s", query.Encode())
 
-	req, err := c.NewRequest(context.Background(), "GET", path, nil)
+	req, err := c.NewRequest(c.requestContext("GetUserArticles"), "GET", path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -278,7 +287,7 @@ func (c *Client) GetUserPublishedArticles(q ArticleQueryParams) ([]Article, erro
 
 	path := fmt.Sprintf("/articles/me/published?%s", query.Encode())
 
-	req, err := c.NewRequest(context.Background(), "GET", path, nil)
+	req, err := c.NewRequest(c.requestContext("GetUserPublishedArticles"), "GET", path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -302,7 +311,7 @@ func (c *Client) GetUserUnPublishedArticles(q ArticleQueryParams) ([]Article, er
 
 	path := fmt.Sprintf("/articles/me/unpublished?%s", query.Encode())
 
-	req, err := c.NewRequest(context.Background(), "GET", path, nil)
+	req, err := c.NewRequest(c.requestContext("GetUserUnPublishedArticles"), "GET", path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -326,7 +335,7 @@ func (c *Client) GetArticlesWithVideo(q ArticleQueryParams) ([]VideoArticle, err
 
 	path := fmt.Sprintf("/videos?%s", query.Encode())
 
-	req, err := c.NewRequest(context.Background(), "GET", path, nil)
+	req, err := c.NewRequest(c.requestContext("GetArticlesWithVideo"), "GET", path, nil)
 	if err != nil {
 		return nil, err
 	}
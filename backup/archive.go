@@ -0,0 +1,228 @@
+package backup
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+)
+
+const manifestFile = "manifest.json"
+
+type archiveFile struct {
+	name  string
+	value interface{}
+}
+
+func (a *Archive) files() []archiveFile {
+	return []archiveFile{
+		{manifestFile, &a.Manifest},
+		{"user.json", &a.User},
+		{"articles.json", &a.Articles},
+		{"reading_list.json", &a.ReadingList},
+		{"followed_tags.json", &a.FollowedTags},
+		{"followers.json", &a.Followers},
+		{"webhooks.json", &a.Webhooks},
+		{"listings.json", &a.Listings},
+	}
+}
+
+// WriteZip writes the archive as a zip file of JSON documents
+func (a *Archive) WriteZip(w io.Writer) error {
+	zw := zip.NewWriter(w)
+
+	for _, f := range a.files() {
+		b, err := json.MarshalIndent(f.value, "", "  ")
+		if err != nil {
+			return err
+		}
+
+		fw, err := zw.CreateHeader(&zip.FileHeader{
+			Name:     f.name,
+			Method:   zip.Deflate,
+			Modified: a.Manifest.CreatedAt,
+		})
+		if err != nil {
+			return err
+		}
+
+		if _, err := fw.Write(b); err != nil {
+			return err
+		}
+	}
+
+	return zw.Close()
+}
+
+// WriteTarGz writes the archive as a gzipped tarball of JSON documents
+func (a *Archive) WriteTarGz(w io.Writer) error {
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+
+	for _, f := range a.files() {
+		b, err := json.MarshalIndent(f.value, "", "  ")
+		if err != nil {
+			return err
+		}
+
+		hdr := &tar.Header{
+			Name:    f.name,
+			Mode:    0644,
+			Size:    int64(len(b)),
+			ModTime: a.Manifest.CreatedAt,
+		}
+
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+
+		if _, err := tw.Write(b); err != nil {
+			return err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+
+	return gw.Close()
+}
+
+// WriteFile writes the archive to path, the format is chosen from
+// the extension (.zip, .tar.gz or .tgz)
+func (a *Archive) WriteFile(path string) error {
+	var buf bytes.Buffer
+
+	switch {
+	case strings.HasSuffix(path, ".zip"):
+		if err := a.WriteZip(&buf); err != nil {
+			return err
+		}
+	case strings.HasSuffix(path, ".tar.gz"), strings.HasSuffix(path, ".tgz"):
+		if err := a.WriteTarGz(&buf); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unsupported archive extension: '%s'", path)
+	}
+
+	return ioutil.WriteFile(path, buf.Bytes(), 0600)
+}
+
+// ReadFile reads an archive written by WriteFile
+func ReadFile(path string) (*Archive, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	switch {
+	case strings.HasSuffix(path, ".zip"):
+		info, err := f.Stat()
+		if err != nil {
+			return nil, err
+		}
+
+		return ReadZip(f, info.Size())
+	case strings.HasSuffix(path, ".tar.gz"), strings.HasSuffix(path, ".tgz"):
+		return ReadTarGz(f)
+	}
+
+	return nil, fmt.Errorf("unsupported archive extension: '%s'", path)
+}
+
+// ReadZip reads an archive written by WriteZip
+func ReadZip(r io.ReaderAt, size int64) (*Archive, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, err
+	}
+
+	contents := map[string][]byte{}
+
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		if err != nil {
+			return nil, err
+		}
+
+		b, err := ioutil.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		contents[f.Name] = b
+	}
+
+	return decode(contents)
+}
+
+// ReadTarGz reads an archive written by WriteTarGz
+func ReadTarGz(r io.Reader) (*Archive, error) {
+	gr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer gr.Close()
+
+	tr := tar.NewReader(gr)
+	contents := map[string][]byte{}
+
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		b, err := ioutil.ReadAll(tr)
+		if err != nil {
+			return nil, err
+		}
+
+		contents[hdr.Name] = b
+	}
+
+	return decode(contents)
+}
+
+func decode(contents map[string][]byte) (*Archive, error) {
+	a := new(Archive)
+
+	manifest, ok := contents[manifestFile]
+	if !ok {
+		return nil, errors.New("archive has no manifest")
+	}
+
+	if err := json.Unmarshal(manifest, &a.Manifest); err != nil {
+		return nil, err
+	}
+
+	if a.Manifest.Version < 1 || a.Manifest.Version > Version {
+		return nil, fmt.Errorf("unsupported archive version: %d", a.Manifest.Version)
+	}
+
+	for _, f := range a.files() {
+		b, ok := contents[f.name]
+		if !ok || f.name == manifestFile {
+			continue
+		}
+
+		if err := json.Unmarshal(b, f.value); err != nil {
+			return nil, fmt.Errorf("%s: %w", f.name, err)
+		}
+	}
+
+	return a, nil
+}
@@ -0,0 +1,134 @@
+// Package backup dumps everything an api key can see into a versioned
+// archive and restores drafts, listings and webhooks on another instance
+package backup
+
+import (
+	"time"
+
+	dev "github.com/Mayowa-Ojo/dev-client-go"
+)
+
+// Version is the archive format version written by this package
+const Version = 1
+
+const perPage = 100
+
+type Manifest struct {
+	Version   int       `json:"version"`
+	CreatedAt time.Time `json:"created_at"`
+	BaseURL   string    `json:"base_url"`
+	Username  string    `json:"username"`
+}
+
+// Archive holds everything dumped from an account. Each field is stored as
+// its own JSON file in the archive
+type Archive struct {
+	Manifest     Manifest
+	User         *dev.User
+	Articles     []dev.Article
+	ReadingList  []dev.ReadingList
+	FollowedTags []dev.Tag
+	Followers    []dev.User
+	Webhooks     []dev.Webhook
+	Listings     []dev.Listing
+}
+
+type Options struct {
+	// ListingIDs are listings to include. The api has no endpoint listing
+	// the user's own listings, so they have to be given explicitly
+	ListingIDs []string
+	// Organizations whose listings are included
+	Organizations []string
+}
+
+// Create dumps the account the client is authenticated as
+func Create(c *dev.Client, opts Options) (*Archive, error) {
+	user, err := c.GetAuthenticatedUser()
+	if err != nil {
+		return nil, err
+	}
+
+	a := &Archive{
+		Manifest: Manifest{
+			Version:   Version,
+			CreatedAt: time.Now().UTC(),
+			BaseURL:   c.BaseUrl.String(),
+			Username:  user.Username,
+		},
+		User: user,
+	}
+
+	// the list of all articles includes drafts along with their markdown
+	for page := int32(1); ; page++ {
+		articles, err := c.GetUserArticles(dev.ArticleQueryParams{Page: page, PerPage: perPage})
+		if err != nil {
+			return nil, err
+		}
+
+		a.Articles = append(a.Articles, articles...)
+
+		if len(articles) < perPage {
+			break
+		}
+	}
+
+	for page := int32(1); ; page++ {
+		items, err := c.GetUserReadingList(dev.ReadingListQueryParams{Page: page, PerPage: perPage})
+		if err != nil {
+			return nil, err
+		}
+
+		a.ReadingList = append(a.ReadingList, items...)
+
+		if len(items) < perPage {
+			break
+		}
+	}
+
+	for page := int32(1); ; page++ {
+		followers, err := c.GetUserFollowers(dev.UserQueryParams{Page: page, PerPage: perPage})
+		if err != nil {
+			return nil, err
+		}
+
+		a.Followers = append(a.Followers, followers...)
+
+		if len(followers) < perPage {
+			break
+		}
+	}
+
+	if a.FollowedTags, err = c.GetFollowedTags(); err != nil {
+		return nil, err
+	}
+
+	if a.Webhooks, err = c.GetWebhooks(); err != nil {
+		return nil, err
+	}
+
+	for _, id := range opts.ListingIDs {
+		listing, err := c.GetListingByID(id)
+		if err != nil {
+			return nil, err
+		}
+
+		a.Listings = append(a.Listings, *listing)
+	}
+
+	for _, org := range opts.Organizations {
+		for page := int32(1); ; page++ {
+			listings, err := c.GetOrganizationListings(org, dev.OrganizationQueryParams{Page: page, PerPage: perPage})
+			if err != nil {
+				return nil, err
+			}
+
+			a.Listings = append(a.Listings, listings...)
+
+			if len(listings) < perPage {
+				break
+			}
+		}
+	}
+
+	return a, nil
+}
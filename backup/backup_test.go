@@ -0,0 +1,191 @@
+package backup
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"testing"
+
+	dev "github.com/Mayowa-Ojo/dev-client-go"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *dev.Client {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	u, _ := url.Parse(server.URL)
+
+	return &dev.Client{Client: server.Client(), BaseUrl: u, Token: "test-token"}
+}
+
+func TestCreateAndReadArchive(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/users/me":
+			json.NewEncoder(w).Encode(dev.User{ID: 7, Username: "jane"})
+		case "/articles/me/all":
+			json.NewEncoder(w).Encode([]dev.Article{
+				{ID: 1, Title: "Published", Published: true, BodyMarkdown: "published body"},
+				{ID: 2, Title: "Draft", BodyMarkdown: "draft body"},
+			})
+		case "/readinglist":
+			json.NewEncoder(w).Encode([]dev.ReadingList{{ID: 3}})
+		case "/followers/users":
+			json.NewEncoder(w).Encode([]dev.User{{ID: 8, Username: "john"}})
+		case "/follows/tags":
+			json.NewEncoder(w).Encode([]dev.Tag{{ID: 4, Name: "go"}})
+		case "/webhooks":
+			json.NewEncoder(w).Encode([]dev.Webhook{{ID: 5, TargetURL: "https://example.com/hook"}})
+		case "/listings/6":
+			json.NewEncoder(w).Encode(dev.Listing{ID: 6, Title: "Hiring"})
+		default:
+			t.Errorf("Unexpected request: %s", r.URL.Path)
+		}
+	})
+
+	a, err := Create(c, Options{ListingIDs: []string{"6"}})
+	if err != nil {
+		t.Fatalf("Error creating archive: %s", err.Error())
+	}
+
+	if a.Manifest.Username != "jane" || a.Manifest.Version != Version {
+		t.Errorf("Unexpected manifest: %+v", a.Manifest)
+	}
+
+	for _, name := range []string{"backup.zip", "backup.tar.gz"} {
+		path := filepath.Join(t.TempDir(), name)
+
+		if err := a.WriteFile(path); err != nil {
+			t.Fatalf("Error writing %s: %s", name, err.Error())
+		}
+
+		got, err := ReadFile(path)
+		if err != nil {
+			t.Fatalf("Error reading %s: %s", name, err.Error())
+		}
+
+		if len(got.Articles) != 2 || got.Articles[1].BodyMarkdown != "draft body" {
+			t.Errorf("%s: unexpected articles: %+v", name, got.Articles)
+		}
+
+		if len(got.ReadingList) != 1 || len(got.Followers) != 1 || len(got.FollowedTags) != 1 ||
+			len(got.Webhooks) != 1 || len(got.Listings) != 1 {
+			t.Errorf("%s: archive is missing data: %+v", name, got)
+		}
+
+		if got.User == nil || got.User.ID != 7 {
+			t.Errorf("%s: unexpected user: %+v", name, got.User)
+		}
+	}
+}
+
+func TestReadFileRejectsNewerVersion(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "backup.zip")
+
+	a := &Archive{Manifest: Manifest{Version: Version + 1}}
+	if err := a.WriteFile(path); err != nil {
+		t.Fatalf("Error writing archive: %s", err.Error())
+	}
+
+	if _, err := ReadFile(path); err == nil {
+		t.Error("Expected an error for an unsupported archive version")
+	}
+}
+
+func TestRestore(t *testing.T) {
+	var created []string
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			t.Errorf("Unexpected method: %s", r.Method)
+		}
+
+		created = append(created, r.URL.Path)
+
+		switch r.URL.Path {
+		case "/articles":
+			var payload dev.ArticleBodySchema
+			json.NewDecoder(r.Body).Decode(&payload)
+
+			if payload.Article.Published {
+				t.Error("Expected article to be restored as a draft")
+			}
+
+			json.NewEncoder(w).Encode(dev.ArticleVariant{Article: dev.Article{ID: 102, Title: payload.Article.Title}})
+		case "/listings":
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(map[string]interface{}{"error": "invalid listing", "status": 422})
+		case "/webhooks":
+			json.NewEncoder(w).Encode(dev.Webhook{ID: 105})
+		default:
+			t.Errorf("Unexpected request: %s", r.URL.Path)
+		}
+	})
+
+	a := &Archive{
+		Manifest: Manifest{Version: Version},
+		Articles: []dev.Article{
+			{ID: 1, Title: "Published", Published: true, BodyMarkdown: "published body"},
+			{ID: 2, Title: "Draft", BodyMarkdown: "draft body"},
+		},
+		Listings: []dev.Listing{{ID: 6, Title: "Hiring", BodyMarkdown: "body", Category: dev.ListingCategory("jobs")}},
+		Webhooks: []dev.Webhook{{ID: 5, Source: "DEV", TargetURL: "https://example.com/hook", Events: []string{"article_created"}}},
+	}
+
+	report, err := Restore(c, a, RestoreOptions{})
+	if err != nil {
+		t.Fatalf("Error restoring archive: %s", err.Error())
+	}
+
+	if len(report.Mappings) != 3 {
+		t.Fatalf("Expected 3 mappings, got %+v", report.Mappings)
+	}
+
+	if m := report.Mappings[0]; m.Kind != KindArticle || m.OldID != 2 || m.NewID != 102 {
+		t.Errorf("Unexpected article mapping: %+v", m)
+	}
+
+	if m := report.Mappings[2]; m.Kind != KindWebhook || m.OldID != 5 || m.NewID != 105 {
+		t.Errorf("Unexpected webhook mapping: %+v", m)
+	}
+
+	failed := report.Failed()
+	if len(failed) != 1 || failed[0].Kind != KindListing || failed[0].NewID != 0 {
+		t.Errorf("Expected the listing to fail, got %+v", failed)
+	}
+}
+
+func TestRestorePublishedAsDraft(t *testing.T) {
+	var bodies []string
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var payload dev.ArticleBodySchema
+		json.NewDecoder(r.Body).Decode(&payload)
+
+		if payload.Article.Published {
+			t.Error("Expected article to be restored as a draft")
+		}
+
+		bodies = append(bodies, payload.Article.BodyMarkdown)
+
+		json.NewEncoder(w).Encode(dev.ArticleVariant{Article: dev.Article{ID: 101}})
+	})
+
+	a := &Archive{
+		Manifest: Manifest{Version: Version},
+		Articles: []dev.Article{
+			{ID: 1, Published: true, BodyMarkdown: "---\ntitle: Front matter\npublished: true\ntags: go\n---\n\nbody"},
+		},
+	}
+
+	if _, err := Restore(c, a, RestoreOptions{IncludePublished: true, SkipListings: true, SkipWebhooks: true}); err != nil {
+		t.Fatalf("Error restoring archive: %s", err.Error())
+	}
+
+	expected := "---\ntitle: Front matter\npublished: false\ntags: go\n---\n\nbody"
+	if len(bodies) != 1 || bodies[0] != expected {
+		t.Errorf("Expected the front matter to unpublish the article, got %q", bodies)
+	}
+}
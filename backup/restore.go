@@ -0,0 +1,133 @@
+package backup
+
+import (
+	dev "github.com/Mayowa-Ojo/dev-client-go"
+)
+
+type Kind string
+
+const (
+	KindArticle = Kind("article")
+	KindListing = Kind("listing")
+	KindWebhook = Kind("webhook")
+)
+
+type RestoreOptions struct {
+	// IncludePublished recreates published articles as drafts, by default
+	// only drafts are restored
+	IncludePublished bool
+	SkipListings     bool
+	SkipWebhooks     bool
+}
+
+// Mapping relates an object in the archive to the one created on the
+// target instance. NewID is zero when Error is set
+type Mapping struct {
+	Kind  Kind   `json:"kind"`
+	OldID int64  `json:"old_id"`
+	NewID int64  `json:"new_id,omitempty"`
+	Title string `json:"title"`
+	Error string `json:"error,omitempty"`
+}
+
+type RestoreReport struct {
+	Mappings []Mapping `json:"mappings"`
+}
+
+// Failed returns the mappings of objects that could not be recreated
+func (r *RestoreReport) Failed() []Mapping {
+	var failed []Mapping
+
+	for _, m := range r.Mappings {
+		if m.Error != "" {
+			failed = append(failed, m)
+		}
+	}
+
+	return failed
+}
+
+// Restore recreates the drafts, listings and webhooks of the archive on the
+// instance the client points to. Articles are always created unpublished.
+// A failure to recreate one object is recorded in the report and the
+// restore carries on with the rest
+func Restore(c *dev.Client, a *Archive, opts RestoreOptions) (*RestoreReport, error) {
+	report := &RestoreReport{}
+
+	record := func(kind Kind, oldID, newID int64, title string, err error) {
+		m := Mapping{Kind: kind, OldID: oldID, NewID: newID, Title: title}
+		if err != nil {
+			m.NewID = 0
+			m.Error = err.Error()
+		}
+
+		report.Mappings = append(report.Mappings, m)
+	}
+
+	for _, article := range a.Articles {
+		if article.Published && !opts.IncludePublished {
+			continue
+		}
+
+		payload := dev.ArticleBodySchema{}
+		payload.Article.Title = article.Title
+		payload.Article.Published = false
+
+		// front matter takes precedence over the json fields
+		payload.Article.BodyMarkdown, _ = dev.SetFrontMatterField(article.BodyMarkdown, "published", "false")
+		payload.Article.MainImage = article.CoverImage
+		payload.Article.CanonicalURL = article.CanonicalURL
+		payload.Article.Description = article.Description
+		payload.Article.Tags = article.TagList
+
+		var newID int64
+
+		created, err := c.CreateArticle(payload, nil)
+		if err == nil {
+			newID = int64(created.ID)
+		}
+
+		record(KindArticle, int64(article.ID), newID, article.Title, err)
+	}
+
+	if !opts.SkipListings {
+		for _, listing := range a.Listings {
+			payload := dev.ListingBodySchema{}
+			payload.Listing.Title = listing.Title
+			payload.Listing.BodyMarkdown = listing.BodyMarkdown
+			payload.Listing.Category = listing.Category
+			payload.Listing.Tags = listing.Tags
+			payload.Listing.TagList = listing.TagList
+			payload.Listing.Location = listing.Location
+
+			var newID int64
+
+			created, err := c.CreateListing(payload, nil)
+			if err == nil {
+				newID = created.ID
+			}
+
+			record(KindListing, listing.ID, newID, listing.Title, err)
+		}
+	}
+
+	if !opts.SkipWebhooks {
+		for _, webhook := range a.Webhooks {
+			payload := dev.WebhookBodySchema{}
+			payload.WebhookEndpoint.Source = webhook.Source
+			payload.WebhookEndpoint.TargetURL = webhook.TargetURL
+			payload.WebhookEndpoint.Events = webhook.Events
+
+			var newID int64
+
+			created, err := c.CreateWebhook(payload)
+			if err == nil {
+				newID = created.ID
+			}
+
+			record(KindWebhook, webhook.ID, newID, webhook.TargetURL, err)
+		}
+	}
+
+	return report, nil
+}
@@ -0,0 +1,201 @@
+package dev
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// Profile is a named account on a Forem instance
+type Profile struct {
+	Name    string `json:"name"`
+	BaseURL string `json:"base_url"`
+	Token   string `json:"token"`
+}
+
+// ClientSetConfig is the format of the profiles file read by LoadClientSet
+//
+//	{
+//	  "primary": "dev.to",
+//	  "profiles": [
+//	    {"name": "dev.to", "token": "..."},
+//	    {"name": "community", "base_url": "https://community.example.com/api", "token": "..."}
+//	  ]
+//	}
+type ClientSetConfig struct {
+	Primary  string    `json:"primary"`
+	Profiles []Profile `json:"profiles"`
+}
+
+// ClientSet holds a client per profile, for users who post to several
+// accounts or Forem instances
+type ClientSet struct {
+	primary string
+	clients map[string]*Client
+}
+
+// CrossPostResult is the outcome of creating the article on one profile
+type CrossPostResult struct {
+	Profile string
+	Article *ArticleVariant
+	Err     error
+}
+
+// NewClientSet creates a client for each profile. The base url of a profile
+// defaults to dev.to and primary defaults to the first profile
+func NewClientSet(profiles []Profile, primary string) (*ClientSet, error) {
+	if len(profiles) == 0 {
+		return nil, errors.New("at least one profile is required")
+	}
+
+	cs := &ClientSet{
+		primary: primary,
+		clients: make(map[string]*Client, len(profiles)),
+	}
+
+	for _, p := range profiles {
+		if p.Name == "" {
+			return nil, errors.New("profile name is required")
+		}
+
+		if _, ok := cs.clients[p.Name]; ok {
+			return nil, fmt.Errorf("duplicate profile: '%s'", p.Name)
+		}
+
+		c, err := NewClient(p.Token)
+		if err != nil {
+			return nil, fmt.Errorf("profile '%s': %w", p.Name, err)
+		}
+
+		if p.BaseURL != "" {
+			u, err := url.Parse(strings.TrimSuffix(p.BaseURL, "/"))
+			if err != nil {
+				return nil, fmt.Errorf("profile '%s': %w", p.Name, err)
+			}
+
+			c.BaseUrl = u
+		}
+
+		cs.clients[p.Name] = c
+	}
+
+	if cs.primary == "" {
+		cs.primary = profiles[0].Name
+	}
+
+	if _, ok := cs.clients[cs.primary]; !ok {
+		return nil, fmt.Errorf("unknown primary profile: '%s'", cs.primary)
+	}
+
+	return cs, nil
+}
+
+// LoadClientSet reads the profiles from a json file
+func LoadClientSet(path string) (*ClientSet, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var cfg ClientSetConfig
+	if err := json.Unmarshal(b, &cfg); err != nil {
+		return nil, err
+	}
+
+	return NewClientSet(cfg.Profiles, cfg.Primary)
+}
+
+// Client returns the client of the named profile
+func (cs *ClientSet) Client(name string) (*Client, error) {
+	c, ok := cs.clients[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown profile: '%s'", name)
+	}
+
+	return c, nil
+}
+
+// Primary returns the name of the primary profile
+func (cs *ClientSet) Primary() string {
+	return cs.primary
+}
+
+// Names returns the profile names in alphabetical order
+func (cs *ClientSet) Names() []string {
+	names := make([]string, 0, len(cs.clients))
+	for name := range cs.clients {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// CrossPost creates the article on the primary profile and then on each of
+// the given profiles, with the canonical url of the copies pointing to the
+// primary article. A canonical url already set on the payload or in its
+// front matter is kept for every copy. The article must be published, the
+// url of a draft isn't public. Failures on the other profiles are reported
+// in the results and don't stop the remaining ones, an error is only
+// returned when the primary article can't be created
+func (cs *ClientSet) CrossPost(payload ArticleBodySchema, profiles []string) ([]CrossPostResult, error) {
+	for _, name := range profiles {
+		if _, err := cs.Client(name); err != nil {
+			return nil, err
+		}
+	}
+
+	// front matter takes precedence over the json fields
+	published := payload.Article.Published
+	if v, ok := FrontMatterField(payload.Article.BodyMarkdown, "published"); ok {
+		published = v == "true"
+	}
+
+	if !published {
+		return nil, errors.New("only published articles can be cross-posted")
+	}
+
+	primary, err := cs.clients[cs.primary].CreateArticle(payload, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	results := []CrossPostResult{{Profile: cs.primary, Article: primary}}
+
+	canonical := payload.Article.CanonicalURL
+	if v, ok := FrontMatterField(payload.Article.BodyMarkdown, "canonical_url"); ok && v != "" {
+		canonical = v
+	}
+
+	if canonical == "" {
+		canonical = primary.URL
+	}
+
+	payload.Article.CanonicalURL = canonical
+	payload.Article.BodyMarkdown, _ = SetFrontMatterField(payload.Article.BodyMarkdown, "canonical_url", canonical)
+
+	seen := map[string]bool{cs.primary: true}
+
+	for _, name := range profiles {
+		if seen[name] {
+			continue
+		}
+
+		seen[name] = true
+
+		// organizations are per instance
+		copied := payload
+		copied.Article.OrganizationID = 0
+
+		article, err := cs.clients[name].CreateArticle(copied, nil)
+
+		results = append(results, CrossPostResult{Profile: name, Article: article, Err: err})
+	}
+
+	return results, nil
+}
@@ -0,0 +1,165 @@
+package dev
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadClientSet(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "profiles.json")
+
+	config := `{
+		"primary": "community",
+		"profiles": [
+			{"name": "dev.to", "token": "a"},
+			{"name": "community", "base_url": "https://community.example.com/api/", "token": "b"}
+		]
+	}`
+
+	if err := ioutil.WriteFile(path, []byte(config), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	cs, err := LoadClientSet(path)
+	if err != nil {
+		t.Fatalf("Error loading client set: %s", err.Error())
+	}
+
+	if cs.Primary() != "community" {
+		t.Errorf("Expected primary to be 'community', got '%s'", cs.Primary())
+	}
+
+	c, err := cs.Client("community")
+	if err != nil {
+		t.Fatalf("Error getting client: %s", err.Error())
+	}
+
+	if c.BaseUrl.String() != "https://community.example.com/api" || c.Token != "b" {
+		t.Errorf("Unexpected client: %s %s", c.BaseUrl, c.Token)
+	}
+
+	if c, _ := cs.Client("dev.to"); c.BaseUrl.String() != BASE_URL {
+		t.Errorf("Expected default base url, got %s", c.BaseUrl)
+	}
+
+	if _, err := cs.Client("missing"); err == nil {
+		t.Error("Expected an error for an unknown profile")
+	}
+
+	if _, err := NewClientSet([]Profile{{Name: "a", Token: "a"}, {Name: "a", Token: "b"}}, ""); err == nil {
+		t.Error("Expected an error for duplicate profiles")
+	}
+}
+
+func TestCrossPost(t *testing.T) {
+	var canonical []string
+
+	handler := func(url string, status int) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			var payload ArticleBodySchema
+			json.NewDecoder(r.Body).Decode(&payload)
+
+			canonical = append(canonical, payload.Article.CanonicalURL)
+
+			if status != http.StatusCreated {
+				w.WriteHeader(status)
+				json.NewEncoder(w).Encode(map[string]interface{}{"error": "unprocessable", "status": status})
+				return
+			}
+
+			w.WriteHeader(status)
+			json.NewEncoder(w).Encode(ArticleVariant{Article: Article{ID: 1, URL: url}})
+		}
+	}
+
+	primary := httptest.NewServer(handler("https://dev.to/jane/hello-1a2b", http.StatusCreated))
+	defer primary.Close()
+
+	secondary := httptest.NewServer(handler("https://community.example.com/jane/hello", http.StatusCreated))
+	defer secondary.Close()
+
+	failing := httptest.NewServer(handler("", http.StatusUnprocessableEntity))
+	defer failing.Close()
+
+	cs, err := NewClientSet([]Profile{
+		{Name: "dev.to", BaseURL: primary.URL, Token: "a"},
+		{Name: "community", BaseURL: secondary.URL, Token: "b"},
+		{Name: "broken", BaseURL: failing.URL, Token: "c"},
+	}, "")
+	if err != nil {
+		t.Fatalf("Error creating client set: %s", err.Error())
+	}
+
+	payload := ArticleBodySchema{}
+	payload.Article.Title = "Hello"
+	payload.Article.BodyMarkdown = "Hello world"
+	payload.Article.Published = true
+
+	results, err := cs.CrossPost(payload, []string{"community", "broken", "community", "dev.to"})
+	if err != nil {
+		t.Fatalf("Error cross posting: %s", err.Error())
+	}
+
+	if len(results) != 3 {
+		t.Fatalf("Expected 3 results, got %d", len(results))
+	}
+
+	if results[0].Profile != "dev.to" || results[1].Err != nil || results[2].Err == nil {
+		t.Errorf("Unexpected results: %+v", results)
+	}
+
+	expected := []string{"", "https://dev.to/jane/hello-1a2b", "https://dev.to/jane/hello-1a2b"}
+	if !equalStrings(canonical, expected) {
+		t.Errorf("Expected canonical urls %v, got %v", expected, canonical)
+	}
+
+	if _, err := cs.CrossPost(payload, []string{"missing"}); err == nil {
+		t.Error("Expected an error for an unknown profile")
+	}
+}
+
+func TestCrossPostFrontMatter(t *testing.T) {
+	var bodies []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload ArticleBodySchema
+		json.NewDecoder(r.Body).Decode(&payload)
+
+		bodies = append(bodies, payload.Article.BodyMarkdown)
+
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(ArticleVariant{Article: Article{ID: 1, URL: "https://dev.to/jane/hello-1a2b"}})
+	}))
+	defer server.Close()
+
+	cs, err := NewClientSet([]Profile{
+		{Name: "dev.to", BaseURL: server.URL, Token: "a"},
+		{Name: "community", BaseURL: server.URL, Token: "b"},
+	}, "dev.to")
+	if err != nil {
+		t.Fatalf("Error creating client set: %s", err.Error())
+	}
+
+	payload := ArticleBodySchema{}
+	payload.Article.Published = true
+	payload.Article.BodyMarkdown = "---\ntitle: Hello\npublished: false\ncanonical_url:\n---\n\nHello world"
+
+	if _, err := cs.CrossPost(payload, []string{"community"}); err == nil || len(bodies) != 0 {
+		t.Fatal("Expected a draft in the front matter not to be cross-posted")
+	}
+
+	payload.Article.BodyMarkdown = "---\ntitle: Hello\npublished: true\ncanonical_url:\n---\n\nHello world"
+
+	if _, err := cs.CrossPost(payload, []string{"community"}); err != nil {
+		t.Fatalf("Error cross posting: %s", err.Error())
+	}
+
+	expected := "---\ntitle: Hello\npublished: true\ncanonical_url: https://dev.to/jane/hello-1a2b\n---\n\nHello world"
+	if len(bodies) != 2 || bodies[1] != expected {
+		t.Errorf("Expected the copy's front matter to point to the primary article, got %q", bodies)
+	}
+}
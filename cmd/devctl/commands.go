@@ -0,0 +1,557 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+
+	dev "github.com/Mayowa-Ojo/dev-client-go"
+)
+
+type command func(e *env, args []string) error
+
+var commands = map[string]command{
+	"articles list":    articlesList,
+	"articles get":     articlesGet,
+	"articles create":  articlesCreate,
+	"articles update":  articlesUpdate,
+	"articles publish": articlesPublish,
+	"articles tui":     articlesTUI,
+	"comments get":     commentsGet,
+	"listings list":    listingsList,
+	"listings create":  listingsCreate,
+	"listings bump":    listingsBump,
+	"webhooks list":    webhooksList,
+	"webhooks create":  webhooksCreate,
+	"webhooks delete":  webhooksDelete,
+	"users me":         usersMe,
+	"users get":        usersGet,
+	"orgs get":         orgsGet,
+}
+
+var (
+	articleColumns = []string{"id", "title", "published", "published_at", "tag_list", "url"}
+	commentColumns = []string{"id_code", "depth", "parent", "author", "created_at", "text"}
+	listingColumns = []string{"id", "title", "category", "published", "tag_list"}
+	webhookColumns = []string{"id", "source", "target_url", "events", "created_at"}
+	userColumns    = []string{"id", "username", "name", "location", "joined_at"}
+	orgColumns     = []string{"id", "username", "name", "url", "joined_at"}
+)
+
+const articlesPerPage = 100
+
+// newFlagSet returns a flag set that reports errors to the caller
+// instead of printing them
+func newFlagSet(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(ioutil.Discard)
+
+	return fs
+}
+
+func parse(fs *flag.FlagSet, args []string, nargs int) error {
+	if err := fs.Parse(args); err != nil {
+		return usagef("%s: %s", fs.Name(), err.Error())
+	}
+
+	if fs.NArg() != nargs {
+		return usagef("%s: expected %d argument(s), got %d", fs.Name(), nargs, fs.NArg())
+	}
+
+	return nil
+}
+
+func splitList(s string) []string {
+	var items []string
+
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
+
+func articlesList(e *env, args []string) error {
+	fs := newFlagSet("articles list")
+	page := fs.Int("page", 1, "page number")
+	perPage := fs.Int("per-page", 30, "results per page")
+	mine := fs.String("mine", "", "list your own articles: all, published or unpublished")
+	username := fs.String("username", "", "only articles by this user or organization")
+	tag := fs.String("tag", "", "only articles with this tag")
+
+	if err := parse(fs, args, 0); err != nil {
+		return err
+	}
+
+	q := dev.ArticleQueryParams{
+		Page:     int32(*page),
+		PerPage:  int32(*perPage),
+		Username: *username,
+		Tag:      *tag,
+	}
+
+	var articles []dev.Article
+	var err error
+
+	switch *mine {
+	case "":
+		articles, err = e.client.GetPublishedArticles(q)
+	case "all":
+		articles, err = e.client.GetUserArticles(q)
+	case "published":
+		articles, err = e.client.GetUserPublishedArticles(q)
+	case "unpublished":
+		articles, err = e.client.GetUserUnPublishedArticles(q)
+	default:
+		return usagef("articles list: invalid -mine value '%s'", *mine)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return e.out.print(articles, articleColumns)
+}
+
+func articlesGet(e *env, args []string) error {
+	fs := newFlagSet("articles get")
+
+	if err := parse(fs, args, 1); err != nil {
+		return err
+	}
+
+	article, err := e.client.GetPublishedArticleByID(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	return e.out.print(article, articleColumns)
+}
+
+type articleFlags struct {
+	title, file, body, tags, series, description, canonicalURL, cover string
+	published                                                         bool
+	orgID                                                             int
+}
+
+func (f *articleFlags) register(fs *flag.FlagSet) {
+	fs.StringVar(&f.title, "title", "", "article title")
+	fs.StringVar(&f.file, "file", "", "markdown file with the article body")
+	fs.StringVar(&f.body, "body", "", "article body as markdown")
+	fs.StringVar(&f.tags, "tags", "", "comma separated tags")
+	fs.StringVar(&f.series, "series", "", "series the article belongs to")
+	fs.StringVar(&f.description, "description", "", "article description")
+	fs.StringVar(&f.canonicalURL, "canonical-url", "", "canonical url of the article")
+	fs.StringVar(&f.cover, "cover", "", "cover image url")
+	fs.BoolVar(&f.published, "published", false, "publish the article")
+	fs.IntVar(&f.orgID, "org-id", 0, "organization to publish under")
+}
+
+// apply copies the flags that were set on the command line to the payload
+func (f *articleFlags) apply(fs *flag.FlagSet, payload *dev.ArticleBodySchema) {
+	fs.Visit(func(fl *flag.Flag) {
+		a := &payload.Article
+
+		switch fl.Name {
+		case "title":
+			a.Title = f.title
+		case "body":
+			a.BodyMarkdown = f.body
+		case "tags":
+			a.Tags = splitList(f.tags)
+		case "series":
+			a.Series = f.series
+		case "description":
+			a.Description = f.description
+		case "canonical-url":
+			a.CanonicalURL = f.canonicalURL
+		case "cover":
+			a.MainImage = f.cover
+		case "published":
+			a.Published = f.published
+		case "org-id":
+			a.OrganizationID = int32(f.orgID)
+		}
+	})
+}
+
+// filepath returns the markdown file argument expected by the client
+func (f *articleFlags) filepath() interface{} {
+	if f.file == "" {
+		return nil
+	}
+
+	return f.file
+}
+
+func articlesCreate(e *env, args []string) error {
+	fs := newFlagSet("articles create")
+
+	var f articleFlags
+	f.register(fs)
+
+	if err := parse(fs, args, 0); err != nil {
+		return err
+	}
+
+	var payload dev.ArticleBodySchema
+	f.apply(fs, &payload)
+
+	article, err := e.client.CreateArticle(payload, f.filepath())
+	if err != nil {
+		return err
+	}
+
+	return e.out.print(article, articleColumns)
+}
+
+// findOwnArticle looks up one of the user's articles, drafts included.
+// The update endpoint replaces every field so the current values are
+// needed to change only some of them
+func findOwnArticle(c *dev.Client, id string) (*dev.Article, error) {
+	articleID, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, usagef("invalid article id '%s'", id)
+	}
+
+	for page := int32(1); ; page++ {
+		articles, err := c.GetUserArticles(dev.ArticleQueryParams{Page: page, PerPage: articlesPerPage})
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range articles {
+			if int(articles[i].ID) == articleID {
+				return &articles[i], nil
+			}
+		}
+
+		if len(articles) < articlesPerPage {
+			return nil, fmt.Errorf("article %s not found among your articles", id)
+		}
+	}
+}
+
+func payloadFromArticle(a *dev.Article) dev.ArticleBodySchema {
+	var payload dev.ArticleBodySchema
+
+	payload.Article.Title = a.Title
+	payload.Article.BodyMarkdown = a.BodyMarkdown
+	payload.Article.Published = a.Published
+	payload.Article.MainImage = a.CoverImage
+	payload.Article.CanonicalURL = a.CanonicalURL
+	payload.Article.Description = a.Description
+	payload.Article.Tags = a.TagList
+
+	return payload
+}
+
+func articlesUpdate(e *env, args []string) error {
+	fs := newFlagSet("articles update")
+
+	var f articleFlags
+	f.register(fs)
+
+	if err := parse(fs, args, 1); err != nil {
+		return err
+	}
+
+	current, err := findOwnArticle(e.client, fs.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	payload := payloadFromArticle(current)
+	f.apply(fs, &payload)
+
+	article, err := e.client.UpdateArticle(fs.Arg(0), payload, f.filepath())
+	if err != nil {
+		return err
+	}
+
+	return e.out.print(article, articleColumns)
+}
+
+func articlesPublish(e *env, args []string) error {
+	fs := newFlagSet("articles publish")
+
+	if err := parse(fs, args, 1); err != nil {
+		return err
+	}
+
+	current, err := findOwnArticle(e.client, fs.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	payload := payloadFromArticle(current)
+	payload.Article.Published = true
+
+	// front matter takes precedence over the json fields
+	payload.Article.BodyMarkdown, _ = dev.SetFrontMatterField(payload.Article.BodyMarkdown, "published", "true")
+
+	article, err := e.client.UpdateArticle(fs.Arg(0), payload, nil)
+	if err != nil {
+		return err
+	}
+
+	return e.out.print(article, articleColumns)
+}
+
+// commentRow is the table view of a comment, threads are flattened
+// into one row per comment
+type commentRow struct {
+	IDCode    string `json:"id_code"`
+	Depth     int    `json:"depth"`
+	Parent    string `json:"parent"`
+	Author    string `json:"author"`
+	CreatedAt string `json:"created_at"`
+	Text      string `json:"text"`
+}
+
+func commentsGet(e *env, args []string) error {
+	fs := newFlagSet("comments get")
+	articleID := fs.Int("article", 0, "article whose comments are returned")
+	episodeID := fs.Int("podcast-episode", 0, "podcast episode whose comments are returned")
+
+	if err := fs.Parse(args); err != nil {
+		return usagef("comments get: %s", err.Error())
+	}
+
+	var comments []dev.Comment
+
+	switch {
+	case fs.NArg() == 1 && *articleID == 0 && *episodeID == 0:
+		comment, err := e.client.GetComment(fs.Arg(0))
+		if err != nil {
+			return err
+		}
+
+		comments = []dev.Comment{*comment}
+	case fs.NArg() == 0 && *articleID != 0 && *episodeID == 0:
+		result, err := e.client.GetArticleComments(int32(*articleID))
+		if err != nil {
+			return err
+		}
+
+		comments = result
+	case fs.NArg() == 0 && *episodeID != 0 && *articleID == 0:
+		result, err := e.client.GetPodcastEpisodeComments(int32(*episodeID))
+		if err != nil {
+			return err
+		}
+
+		comments = result
+	default:
+		return usagef("comments get: expected a comment id, -article or -podcast-episode")
+	}
+
+	if e.out.format != formatTable {
+		return e.out.print(comments, nil)
+	}
+
+	var rows []commentRow
+	for _, fc := range dev.FlattenComments(comments) {
+		row := commentRow{
+			IDCode:    fc.Comment.IDCode,
+			Depth:     fc.Depth,
+			Parent:    fc.ParentID,
+			CreatedAt: fc.Comment.CreatedAt,
+		}
+
+		if text, err := fc.Comment.Text(); err == nil {
+			row.Text = text
+		}
+
+		if fc.Comment.User != nil {
+			row.Author = fc.Comment.User.Username
+		}
+
+		rows = append(rows, row)
+	}
+
+	return e.out.print(rows, commentColumns)
+}
+
+func listingsList(e *env, args []string) error {
+	fs := newFlagSet("listings list")
+	page := fs.Int("page", 1, "page number")
+	perPage := fs.Int("per-page", 30, "results per page")
+	category := fs.String("category", "", "only listings in this category")
+
+	if err := parse(fs, args, 0); err != nil {
+		return err
+	}
+
+	listings, err := e.client.GetPublishedListings(dev.ListingQueryParams{
+		Page:     int32(*page),
+		PerPage:  int32(*perPage),
+		Category: dev.ListingCategory(*category),
+	})
+	if err != nil {
+		return err
+	}
+
+	return e.out.print(listings, listingColumns)
+}
+
+func listingsCreate(e *env, args []string) error {
+	fs := newFlagSet("listings create")
+	title := fs.String("title", "", "listing title")
+	file := fs.String("file", "", "markdown file with the listing body")
+	body := fs.String("body", "", "listing body as markdown")
+	category := fs.String("category", "", "listing category")
+	tags := fs.String("tags", "", "comma separated tags")
+	expires := fs.String("expires", "", "expiry date, e.g. 2021-12-31")
+	location := fs.String("location", "", "listing location")
+	orgID := fs.Int64("org-id", 0, "organization to post under")
+
+	if err := parse(fs, args, 0); err != nil {
+		return err
+	}
+
+	var payload dev.ListingBodySchema
+	payload.Listing.Title = *title
+	payload.Listing.BodyMarkdown = *body
+	payload.Listing.Category = dev.ListingCategory(*category)
+	payload.Listing.Tags = splitList(*tags)
+	payload.Listing.ExpiresAt = *expires
+	payload.Listing.Location = *location
+	payload.Listing.OrganizationID = *orgID
+
+	var filepath interface{}
+	if *file != "" {
+		filepath = *file
+	}
+
+	listing, err := e.client.CreateListing(payload, filepath)
+	if err != nil {
+		return err
+	}
+
+	return e.out.print(listing, listingColumns)
+}
+
+func listingsBump(e *env, args []string) error {
+	fs := newFlagSet("listings bump")
+
+	if err := parse(fs, args, 1); err != nil {
+		return err
+	}
+
+	listing, err := e.client.BumpListing(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	return e.out.print(listing, listingColumns)
+}
+
+func webhooksList(e *env, args []string) error {
+	fs := newFlagSet("webhooks list")
+
+	if err := parse(fs, args, 0); err != nil {
+		return err
+	}
+
+	webhooks, err := e.client.GetWebhooks()
+	if err != nil {
+		return err
+	}
+
+	return e.out.print(webhooks, webhookColumns)
+}
+
+func webhooksCreate(e *env, args []string) error {
+	fs := newFlagSet("webhooks create")
+	source := fs.String("source", "", "name of the webhook source")
+	targetURL := fs.String("target-url", "", "url the events are sent to")
+	events := fs.String("events", "", "comma separated events, e.g. article_created")
+
+	if err := parse(fs, args, 0); err != nil {
+		return err
+	}
+
+	if *source == "" || *targetURL == "" || *events == "" {
+		return usagef("webhooks create: -source, -target-url and -events are required")
+	}
+
+	var payload dev.WebhookBodySchema
+	payload.WebhookEndpoint.Source = *source
+	payload.WebhookEndpoint.TargetURL = *targetURL
+	payload.WebhookEndpoint.Events = splitList(*events)
+
+	webhook, err := e.client.CreateWebhook(payload)
+	if err != nil {
+		return err
+	}
+
+	return e.out.print(webhook, webhookColumns)
+}
+
+func webhooksDelete(e *env, args []string) error {
+	fs := newFlagSet("webhooks delete")
+
+	if err := parse(fs, args, 1); err != nil {
+		return err
+	}
+
+	return e.client.DeleteWebhook(fs.Arg(0))
+}
+
+func usersMe(e *env, args []string) error {
+	fs := newFlagSet("users me")
+
+	if err := parse(fs, args, 0); err != nil {
+		return err
+	}
+
+	user, err := e.client.GetAuthenticatedUser()
+	if err != nil {
+		return err
+	}
+
+	return e.out.print(user, userColumns)
+}
+
+func usersGet(e *env, args []string) error {
+	fs := newFlagSet("users get")
+
+	if err := parse(fs, args, 1); err != nil {
+		return err
+	}
+
+	var user *dev.User
+	var err error
+
+	if _, convErr := strconv.Atoi(fs.Arg(0)); convErr == nil {
+		user, err = e.client.GetUserByID(fs.Arg(0))
+	} else {
+		user, err = e.client.GetUserByUsername(dev.UserQueryParams{URL: fs.Arg(0)})
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return e.out.print(user, userColumns)
+}
+
+func orgsGet(e *env, args []string) error {
+	fs := newFlagSet("orgs get")
+
+	if err := parse(fs, args, 1); err != nil {
+		return err
+	}
+
+	org, err := e.client.GetOrganization(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	return e.out.print(org, orgColumns)
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// config is read from $XDG_CONFIG_HOME/devctl/config.json unless another
+// path is given with -config or DEVCTL_CONFIG
+type config struct {
+	Token   string `json:"token"`
+	BaseURL string `json:"base_url"`
+}
+
+func defaultConfigPath() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+
+	return filepath.Join(dir, "devctl", "config.json")
+}
+
+// loadConfig resolves the token and base url. Environment variables take
+// precedence over the config file, a missing config file is not an error
+// unless its path was given explicitly
+func loadConfig(path string, getenv func(string) string) (*config, error) {
+	cfg := &config{}
+
+	explicit := path != ""
+	if !explicit {
+		path = getenv("DEVCTL_CONFIG")
+		explicit = path != ""
+	}
+
+	if !explicit {
+		path = defaultConfigPath()
+	}
+
+	if path != "" {
+		b, err := ioutil.ReadFile(path)
+		switch {
+		case err == nil:
+			if err := json.Unmarshal(b, cfg); err != nil {
+				return nil, err
+			}
+		case os.IsNotExist(err) && !explicit:
+		default:
+			return nil, err
+		}
+	}
+
+	if token := getenv("DEV_API_KEY"); token != "" {
+		cfg.Token = token
+	}
+
+	if baseURL := getenv("DEV_BASE_URL"); baseURL != "" {
+		cfg.BaseURL = baseURL
+	}
+
+	if cfg.Token == "" {
+		return nil, errors.New("no api key, set DEV_API_KEY or add a token to the config file")
+	}
+
+	return cfg, nil
+}
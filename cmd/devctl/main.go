@@ -0,0 +1,168 @@
+// Command devctl is a command-line client for the DEV (Forem) api.
+//
+// Usage:
+//
+//	devctl [-o json|table|yaml] [-config path] <resource> <command> [flags] [args]
+//
+// The api key is read from DEV_API_KEY or the token field of the config
+// file, the base url from DEV_BASE_URL or the base_url field.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"strings"
+
+	dev "github.com/Mayowa-Ojo/dev-client-go"
+)
+
+// Exit codes returned by devctl
+const (
+	exitOK          = 0
+	exitError       = 1
+	exitUsage       = 2
+	exitAuth        = 3
+	exitNotFound    = 4
+	exitInvalid     = 5
+	exitRateLimited = 6
+	exitServer      = 7
+)
+
+const usage = `usage: devctl [-o json|table|yaml] [-config path] <resource> <command> [flags] [args]
+
+resources:
+  articles  list | get <id> | create | update <id> | publish <id> | tui
+  comments  get [<id>] [-article id | -podcast-episode id]
+  listings  list | create | bump <id>
+  webhooks  list | create | delete <id>
+  users     me | get <id|username>
+  orgs      get <name>
+`
+
+type usageError struct {
+	msg string
+}
+
+func (e *usageError) Error() string {
+	return e.msg
+}
+
+func usagef(format string, args ...interface{}) error {
+	return &usageError{msg: fmt.Sprintf(format, args...)}
+}
+
+type env struct {
+	client *dev.Client
+	in     io.Reader
+	out    *printer
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Getenv, os.Stdin, os.Stdout, os.Stderr))
+}
+
+func run(args []string, getenv func(string) string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("devctl", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() { fmt.Fprint(stderr, usage) }
+
+	format := fs.String("o", formatTable, "output format: json, table or yaml")
+	configPath := fs.String("config", "", "path to the config file")
+
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return exitOK
+		}
+
+		return exitUsage
+	}
+
+	switch *format {
+	case formatJSON, formatTable, formatYAML:
+	default:
+		fmt.Fprintf(stderr, "devctl: unsupported output format: '%s'\n", *format)
+		return exitUsage
+	}
+
+	if fs.NArg() < 2 {
+		fmt.Fprint(stderr, usage)
+		return exitUsage
+	}
+
+	cfg, err := loadConfig(*configPath, getenv)
+	if err != nil {
+		fmt.Fprintf(stderr, "devctl: %s\n", err.Error())
+		return exitAuth
+	}
+
+	client, err := dev.NewClient(cfg.Token)
+	if err != nil {
+		fmt.Fprintf(stderr, "devctl: %s\n", err.Error())
+		return exitAuth
+	}
+
+	if cfg.BaseURL != "" {
+		u, err := url.Parse(strings.TrimSuffix(cfg.BaseURL, "/"))
+		if err != nil {
+			fmt.Fprintf(stderr, "devctl: invalid base url: %s\n", err.Error())
+			return exitUsage
+		}
+
+		client.BaseUrl = u
+	}
+
+	e := &env{client: client, in: stdin, out: &printer{w: stdout, format: *format}}
+
+	resource, command, rest := fs.Arg(0), fs.Arg(1), fs.Args()[2:]
+
+	cmd, ok := commands[resource+" "+command]
+	if !ok {
+		fmt.Fprintf(stderr, "devctl: unknown command '%s %s'\n\n%s", resource, command, usage)
+		return exitUsage
+	}
+
+	if err := cmd(e, rest); err != nil {
+		fmt.Fprintf(stderr, "devctl: %s\n", err.Error())
+		return exitCode(err)
+	}
+
+	return exitOK
+}
+
+// exitCode maps an error to the exit status of the process. Api errors are
+// grouped by their http status code
+func exitCode(err error) int {
+	var uerr *usageError
+	if errors.As(err, &uerr) {
+		return exitUsage
+	}
+
+	var verrs dev.ValidationErrors
+	if errors.As(err, &verrs) {
+		return exitInvalid
+	}
+
+	var apiErr *dev.DevAPIError
+	if !errors.As(err, &apiErr) {
+		return exitError
+	}
+
+	switch code := apiErr.StatusCode(); {
+	case code == 401 || code == 403:
+		return exitAuth
+	case code == 404:
+		return exitNotFound
+	case code == 400 || code == 422:
+		return exitInvalid
+	case code == 429:
+		return exitRateLimited
+	case code >= 500:
+		return exitServer
+	}
+
+	return exitError
+}
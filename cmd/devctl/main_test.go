@@ -0,0 +1,165 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	dev "github.com/Mayowa-Ojo/dev-client-go"
+)
+
+func newTestServer(t *testing.T) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("api-key") != "test-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			json.NewEncoder(w).Encode(map[string]interface{}{"error": "unauthorized", "status": 401})
+			return
+		}
+
+		switch r.URL.Path {
+		case "/users/me":
+			json.NewEncoder(w).Encode(dev.User{ID: 7, Username: "jane", Name: "Jane Doe"})
+		case "/articles/me/all":
+			json.NewEncoder(w).Encode([]dev.Article{
+				{ID: 1, Title: "Draft", BodyMarkdown: "---\ntitle: Draft\npublished: false\n---\nbody", TagList: []string{"go"}},
+			})
+		case "/articles/1":
+			var payload dev.ArticleBodySchema
+			var raw map[string]map[string]interface{}
+
+			b, _ := io.ReadAll(r.Body)
+			json.Unmarshal(b, &payload)
+			json.Unmarshal(b, &raw)
+
+			// unset fields would clear the article's series and organization
+			for _, key := range []string{"series", "organization_id"} {
+				if _, ok := raw["article"][key]; ok {
+					t.Errorf("Expected %s not to be sent: %s", key, b)
+				}
+			}
+
+			if r.Method != "PUT" || !payload.Article.Published ||
+				payload.Article.BodyMarkdown != "---\ntitle: Draft\npublished: true\n---\nbody" {
+				t.Errorf("Unexpected publish request: %s %+v", r.Method, payload)
+			}
+
+			json.NewEncoder(w).Encode(dev.ArticleVariant{Article: dev.Article{ID: 1, Title: "Draft", Published: true}})
+		case "/organizations/down":
+			w.WriteHeader(http.StatusBadGateway)
+			w.Write([]byte("<html><body>502 Bad Gateway</body></html>"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			json.NewEncoder(w).Encode(map[string]interface{}{"error": "not found", "status": 404})
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func runDevctl(server *httptest.Server, token string, args ...string) (int, string, string) {
+	vars := map[string]string{
+		"DEV_API_KEY":   token,
+		"DEV_BASE_URL":  server.URL,
+		"DEVCTL_CONFIG": "",
+	}
+
+	var stdout, stderr bytes.Buffer
+
+	code := run(args, func(k string) string { return vars[k] }, strings.NewReader(""), &stdout, &stderr)
+
+	return code, stdout.String(), stderr.String()
+}
+
+func TestRunOutputFormats(t *testing.T) {
+	server := newTestServer(t)
+
+	code, out, _ := runDevctl(server, "test-token", "-o", "json", "users", "me")
+	if code != exitOK {
+		t.Fatalf("Expected exit code %d, got %d", exitOK, code)
+	}
+
+	var user dev.User
+	if err := json.Unmarshal([]byte(out), &user); err != nil || user.Username != "jane" {
+		t.Errorf("Unexpected json output: %s", out)
+	}
+
+	_, out, _ = runDevctl(server, "test-token", "articles", "list", "-mine", "all")
+	if !strings.HasPrefix(out, "ID  TITLE") || !strings.Contains(out, "Draft") {
+		t.Errorf("Unexpected table output:\n%s", out)
+	}
+
+	_, out, _ = runDevctl(server, "test-token", "-o", "yaml", "users", "me")
+	if !strings.Contains(out, "username: \"jane\"\n") || !strings.Contains(out, "id: 7\n") {
+		t.Errorf("Unexpected yaml output:\n%s", out)
+	}
+}
+
+func TestRunPublish(t *testing.T) {
+	server := newTestServer(t)
+
+	code, _, stderr := runDevctl(server, "test-token", "articles", "publish", "1")
+	if code != exitOK {
+		t.Errorf("Expected exit code %d, got %d: %s", exitOK, code, stderr)
+	}
+}
+
+func TestRunExitCodes(t *testing.T) {
+	server := newTestServer(t)
+
+	tests := []struct {
+		name  string
+		token string
+		args  []string
+		code  int
+	}{
+		{"unknown command", "test-token", []string{"articles", "delete"}, exitUsage},
+		{"missing argument", "test-token", []string{"orgs", "get"}, exitUsage},
+		{"missing token", "", []string{"users", "me"}, exitAuth},
+		{"unauthorized", "wrong-token", []string{"users", "me"}, exitAuth},
+		{"not found", "test-token", []string{"orgs", "get", "missing"}, exitNotFound},
+		{"gateway error", "test-token", []string{"orgs", "get", "down"}, exitServer},
+		{"invalid payload", "test-token", []string{"articles", "create", "-body", "no title"}, exitInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if code, _, _ := runDevctl(server, tt.token, tt.args...); code != tt.code {
+				t.Errorf("Expected exit code %d, got %d", tt.code, code)
+			}
+		})
+	}
+}
+
+func TestWriteYAML(t *testing.T) {
+	var b strings.Builder
+
+	writeYAML(&b, map[string]interface{}{
+		"name":  "a \"quoted\" value",
+		"tags":  []interface{}{"go", "cli"},
+		"empty": []interface{}{},
+		"user":  map[string]interface{}{"id": float64(1), "admin": false},
+		"items": []interface{}{map[string]interface{}{"id": float64(2)}},
+	}, 0)
+
+	expected := `empty: []
+items:
+  -
+    id: 2
+name: "a \"quoted\" value"
+tags:
+  - "go"
+  - "cli"
+user:
+  admin: false
+  id: 1
+`
+
+	if b.String() != expected {
+		t.Errorf("Unexpected yaml:\n%s", b.String())
+	}
+}
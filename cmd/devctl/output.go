@@ -0,0 +1,231 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+const (
+	formatJSON  = "json"
+	formatTable = "table"
+	formatYAML  = "yaml"
+)
+
+type printer struct {
+	w      io.Writer
+	format string
+}
+
+// print writes v in the selected format. columns are the json keys shown
+// by the table format, all scalar fields are shown when it is empty
+func (p *printer) print(v interface{}, columns []string) error {
+	switch p.format {
+	case formatJSON:
+		enc := json.NewEncoder(p.w)
+		enc.SetIndent("", "  ")
+
+		return enc.Encode(v)
+	case formatYAML, formatTable:
+		// go through json so the field names match the api
+		b, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+
+		var generic interface{}
+		if err := json.Unmarshal(b, &generic); err != nil {
+			return err
+		}
+
+		if p.format == formatYAML {
+			var sb strings.Builder
+			writeYAML(&sb, generic, 0)
+
+			_, err := io.WriteString(p.w, sb.String())
+
+			return err
+		}
+
+		return writeTable(p.w, generic, columns)
+	}
+
+	return fmt.Errorf("unsupported output format: '%s'", p.format)
+}
+
+func writeTable(w io.Writer, v interface{}, columns []string) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+
+	switch v := v.(type) {
+	case []interface{}:
+		if len(columns) == 0 && len(v) > 0 {
+			columns = scalarKeys(v[0])
+		}
+
+		fmt.Fprintln(tw, strings.ToUpper(strings.Join(columns, "\t")))
+
+		for _, item := range v {
+			obj, _ := item.(map[string]interface{})
+
+			cells := make([]string, len(columns))
+			for i, col := range columns {
+				cells[i] = cell(obj[col])
+			}
+
+			fmt.Fprintln(tw, strings.Join(cells, "\t"))
+		}
+	case map[string]interface{}:
+		if len(columns) == 0 {
+			columns = scalarKeys(v)
+		}
+
+		for _, col := range columns {
+			fmt.Fprintf(tw, "%s\t%s\n", strings.ToUpper(col), cell(v[col]))
+		}
+	default:
+		fmt.Fprintln(tw, cell(v))
+	}
+
+	return tw.Flush()
+}
+
+func scalarKeys(v interface{}) []string {
+	obj, _ := v.(map[string]interface{})
+
+	var keys []string
+	for k, value := range obj {
+		switch value.(type) {
+		case map[string]interface{}:
+		default:
+			keys = append(keys, k)
+		}
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+// cell formats a value for a table column, long text is truncated
+func cell(v interface{}) string {
+	var s string
+
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		s = v
+	case float64:
+		s = strconv.FormatFloat(v, 'f', -1, 64)
+	case []interface{}:
+		parts := make([]string, len(v))
+		for i, item := range v {
+			parts[i] = cell(item)
+		}
+		s = strings.Join(parts, ",")
+	default:
+		s = fmt.Sprint(v)
+	}
+
+	s = strings.Join(strings.Fields(s), " ")
+
+	if r := []rune(s); len(r) > 60 {
+		s = string(r[:57]) + "..."
+	}
+
+	return s
+}
+
+// writeYAML writes the decoded json value as block style yaml. Strings
+// are always double quoted so no value needs type-specific escaping
+func writeYAML(b *strings.Builder, v interface{}, indent int) {
+	pad := strings.Repeat("  ", indent)
+
+	switch v := v.(type) {
+	case map[string]interface{}:
+		if len(v) == 0 {
+			b.WriteString(pad + "{}\n")
+			return
+		}
+
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			b.WriteString(pad + yamlKey(k) + ":")
+			writeYAMLValue(b, v[k], indent)
+		}
+	case []interface{}:
+		if len(v) == 0 {
+			b.WriteString(pad + "[]\n")
+			return
+		}
+
+		for _, item := range v {
+			b.WriteString(pad + "-")
+			writeYAMLValue(b, item, indent)
+		}
+	default:
+		b.WriteString(pad + yamlScalar(v) + "\n")
+	}
+}
+
+// writeYAMLValue writes the value following a key or list marker
+func writeYAMLValue(b *strings.Builder, v interface{}, indent int) {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		if len(value) == 0 {
+			b.WriteString(" {}\n")
+			return
+		}
+
+		b.WriteString("\n")
+		writeYAML(b, value, indent+1)
+	case []interface{}:
+		if len(value) == 0 {
+			b.WriteString(" []\n")
+			return
+		}
+
+		b.WriteString("\n")
+		writeYAML(b, value, indent+1)
+	default:
+		b.WriteString(" " + yamlScalar(v) + "\n")
+	}
+}
+
+func yamlScalar(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return strconv.FormatBool(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case string:
+		return strconv.Quote(v)
+	}
+
+	return strconv.Quote(fmt.Sprint(v))
+}
+
+func yamlKey(k string) string {
+	for _, r := range k {
+		if !(r == '_' || r == '-' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9') {
+			return strconv.Quote(k)
+		}
+	}
+
+	if k == "" {
+		return `""`
+	}
+
+	return k
+}
@@ -0,0 +1,383 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	dev "github.com/Mayowa-Ojo/dev-client-go"
+)
+
+// tui is a line based terminal ui for editing and publishing the user's
+// articles. It reads one command per line so it works in any terminal
+// and can be driven from a script
+type tui struct {
+	client    *dev.Client
+	in        *bufio.Scanner
+	out       io.Writer
+	drafts    []dev.Article
+	published []dev.Article
+}
+
+// draft holds the unsaved changes to an article
+type draft struct {
+	article  dev.Article
+	payload  dev.ArticleBodySchema
+	series   string
+	modified bool
+}
+
+func articlesTUI(e *env, args []string) error {
+	fs := newFlagSet("articles tui")
+
+	if err := parse(fs, args, 0); err != nil {
+		return err
+	}
+
+	t := &tui{client: e.client, in: bufio.NewScanner(e.in), out: e.out.w}
+
+	return t.run()
+}
+
+func (t *tui) run() error {
+	if err := t.load(); err != nil {
+		return err
+	}
+
+	for {
+		t.printList()
+
+		input, ok := t.prompt("Select an article number, (r)efresh or (q)uit: ")
+		if !ok || input == "q" {
+			return nil
+		}
+
+		if input == "r" {
+			if err := t.load(); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		n, err := strconv.Atoi(input)
+		if err != nil || n < 1 || n > len(t.drafts)+len(t.published) {
+			fmt.Fprintf(t.out, "Unknown selection '%s'\n", input)
+			continue
+		}
+
+		var a dev.Article
+		if n <= len(t.drafts) {
+			a = t.drafts[n-1]
+		} else {
+			a = t.published[n-1-len(t.drafts)]
+		}
+
+		changed, err := t.edit(a)
+		if err != nil {
+			return err
+		}
+
+		if changed {
+			if err := t.load(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// load fetches the drafts and published articles of the user
+func (t *tui) load() error {
+	drafts, err := t.fetch(t.client.GetUserUnPublishedArticles)
+	if err != nil {
+		return err
+	}
+
+	all, err := t.fetch(t.client.GetUserArticles)
+	if err != nil {
+		return err
+	}
+
+	t.drafts = drafts
+	t.published = t.published[:0]
+
+	for _, a := range all {
+		if a.Published {
+			t.published = append(t.published, a)
+		}
+	}
+
+	return nil
+}
+
+func (t *tui) fetch(list func(dev.ArticleQueryParams) ([]dev.Article, error)) ([]dev.Article, error) {
+	var articles []dev.Article
+
+	for page := int32(1); ; page++ {
+		result, err := list(dev.ArticleQueryParams{Page: page, PerPage: articlesPerPage})
+		if err != nil {
+			return nil, err
+		}
+
+		articles = append(articles, result...)
+
+		if len(result) < articlesPerPage {
+			return articles, nil
+		}
+	}
+}
+
+func (t *tui) printList() {
+	fmt.Fprintln(t.out, "\nDrafts")
+
+	if len(t.drafts) == 0 {
+		fmt.Fprintln(t.out, "  (none)")
+	}
+
+	for i, a := range t.drafts {
+		fmt.Fprintf(t.out, "  %2d. %s\n", i+1, a.Title)
+	}
+
+	fmt.Fprintln(t.out, "\nPublished")
+
+	if len(t.published) == 0 {
+		fmt.Fprintln(t.out, "  (none)")
+	}
+
+	for i, a := range t.published {
+		fmt.Fprintf(t.out, "  %2d. %s (%s)\n", len(t.drafts)+i+1, a.Title, a.ReadablePublishDate)
+	}
+
+	fmt.Fprintln(t.out)
+}
+
+// prompt prints the message and reads a line. It returns false when
+// the input is exhausted
+func (t *tui) prompt(msg string) (string, bool) {
+	fmt.Fprint(t.out, msg)
+
+	if !t.in.Scan() {
+		fmt.Fprintln(t.out)
+		return "", false
+	}
+
+	return strings.TrimSpace(t.in.Text()), true
+}
+
+func (t *tui) confirm(msg string) bool {
+	input, ok := t.prompt(msg + " [y/N]: ")
+
+	return ok && strings.EqualFold(input, "y")
+}
+
+// edit shows the article screen. It reports whether the article was saved
+func (t *tui) edit(a dev.Article) (bool, error) {
+	d := newDraft(a)
+	saved := false
+
+	for {
+		d.print(t.out)
+
+		input, ok := t.prompt("(p)review (t)ags (s)eries (c)over (w)rite (P)ublish/(U)npublish (b)ack: ")
+		if !ok {
+			return saved, nil
+		}
+
+		switch input {
+		case "p":
+			preview(t.out, d.payload.Article.BodyMarkdown)
+		case "t":
+			if value, ok := t.prompt("Tags (comma separated): "); ok {
+				d.setTags(splitList(value))
+			}
+		case "s":
+			if value, ok := t.prompt("Series: "); ok {
+				if err := d.setSeries(value); err != nil {
+					fmt.Fprintf(t.out, "Error: %s\n", err.Error())
+				}
+			}
+		case "c":
+			if value, ok := t.prompt("Cover image url: "); ok {
+				d.setCover(value)
+			}
+		case "w":
+			if !d.modified {
+				fmt.Fprintln(t.out, "No changes to save")
+				continue
+			}
+
+			if err := t.save(d); err != nil {
+				fmt.Fprintf(t.out, "Error: %s\n", err.Error())
+				continue
+			}
+
+			saved = true
+		case "P", "U":
+			publish := input == "P"
+
+			if publish == d.article.Published {
+				fmt.Fprintln(t.out, "Nothing to do")
+				continue
+			}
+
+			verb := "Unpublish"
+			if publish {
+				verb = "Publish"
+			}
+
+			if !t.confirm(fmt.Sprintf("%s '%s'?", verb, d.article.Title)) {
+				continue
+			}
+
+			d.setPublished(publish)
+
+			if err := t.save(d); err != nil {
+				// keep the other changes but not the publish state
+				d.setPublished(!publish)
+				fmt.Fprintf(t.out, "Error: %s\n", err.Error())
+				continue
+			}
+
+			saved = true
+		case "b":
+			if d.modified && !t.confirm("Discard unsaved changes?") {
+				continue
+			}
+
+			return saved, nil
+		default:
+			fmt.Fprintf(t.out, "Unknown command '%s'\n", input)
+		}
+	}
+}
+
+func (t *tui) save(d *draft) error {
+	updated, err := t.client.UpdateArticle(strconv.Itoa(int(d.article.ID)), d.payload, nil)
+	if err != nil {
+		return err
+	}
+
+	d.article.Published = updated.Published
+	d.article.TagList = d.payload.Article.Tags
+	d.article.CoverImage = d.payload.Article.MainImage
+	d.article.BodyMarkdown = d.payload.Article.BodyMarkdown
+	d.modified = false
+
+	fmt.Fprintln(t.out, "Saved")
+
+	return nil
+}
+
+func newDraft(a dev.Article) *draft {
+	d := &draft{article: a, payload: payloadFromArticle(&a)}
+
+	if series, ok := dev.FrontMatterField(a.BodyMarkdown, "series"); ok {
+		d.series = series
+	}
+
+	d.payload.Article.Series = d.series
+
+	return d
+}
+
+func (d *draft) print(w io.Writer) {
+	status := "draft"
+	if d.article.Published {
+		status = "published"
+	}
+
+	if d.modified {
+		status += ", unsaved changes"
+	}
+
+	fmt.Fprintf(w, "\n%s (%s)\n", d.article.Title, status)
+	fmt.Fprintf(w, "  tags:   %s\n", strings.Join(d.payload.Article.Tags, ", "))
+	fmt.Fprintf(w, "  series: %s\n", d.series)
+	fmt.Fprintf(w, "  cover:  %s\n", d.payload.Article.MainImage)
+
+	if d.article.URL != "" {
+		fmt.Fprintf(w, "  url:    %s\n", d.article.URL)
+	}
+
+	fmt.Fprintln(w)
+}
+
+// The editor gives front matter in the body precedence over the json
+// fields, so values that are set there are changed in place
+
+func (d *draft) setTags(tags []string) {
+	d.modified = true
+	d.payload.Article.Tags = tags
+
+	d.setFrontMatter("tags", strings.Join(tags, ", "))
+}
+
+// setSeries changes the series of the article. An empty series isn't sent
+// to the api, so the series can only be cleared in the front matter
+func (d *draft) setSeries(series string) error {
+	if _, ok := dev.FrontMatterField(d.payload.Article.BodyMarkdown, "series"); series == "" && !ok {
+		return errors.New("the series can only be cleared in the front matter of the article")
+	}
+
+	d.modified = true
+	d.series = series
+	d.payload.Article.Series = series
+
+	d.setFrontMatter("series", series)
+
+	return nil
+}
+
+func (d *draft) setCover(url string) {
+	d.modified = true
+	d.payload.Article.MainImage = url
+
+	d.setFrontMatter("cover_image", url)
+}
+
+func (d *draft) setPublished(published bool) {
+	d.payload.Article.Published = published
+
+	d.setFrontMatter("published", strconv.FormatBool(published))
+}
+
+func (d *draft) setFrontMatter(key, value string) {
+	if body, ok := dev.SetFrontMatterField(d.payload.Article.BodyMarkdown, key, value); ok {
+		d.payload.Article.BodyMarkdown = body
+	}
+}
+
+// preview prints the markdown body for reading in a terminal. Front matter
+// is hidden, headings are underlined and code blocks are indented
+func preview(w io.Writer, body string) {
+	body = dev.TrimFrontMatter(body)
+
+	fmt.Fprintln(w, strings.Repeat("-", 72))
+
+	inCode := false
+
+	for _, line := range strings.Split(body, "\n") {
+		switch {
+		case strings.HasPrefix(strings.TrimSpace(line), "```"):
+			inCode = !inCode
+		case inCode:
+			fmt.Fprintln(w, "    "+line)
+		case strings.HasPrefix(line, "#"):
+			heading := strings.TrimSpace(strings.TrimLeft(line, "#"))
+			underline := "-"
+			if strings.HasPrefix(line, "# ") {
+				underline = "="
+			}
+
+			fmt.Fprintln(w, heading)
+			fmt.Fprintln(w, strings.Repeat(underline, len([]rune(heading))))
+		default:
+			fmt.Fprintln(w, line)
+		}
+	}
+
+	fmt.Fprintln(w, strings.Repeat("-", 72))
+}
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	dev "github.com/Mayowa-Ojo/dev-client-go"
+)
+
+func TestArticlesTUI(t *testing.T) {
+	draft := dev.Article{
+		ID:           1,
+		Title:        "Draft",
+		BodyMarkdown: "---\ntitle: Draft\npublished: false\nseries: Go basics\n---\n\n# Intro\n",
+		TagList:      []string{"go"},
+	}
+
+	var updates []dev.ArticleBodySchema
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/articles/me/unpublished":
+			json.NewEncoder(w).Encode([]dev.Article{draft})
+		case "/articles/me/all":
+			json.NewEncoder(w).Encode([]dev.Article{draft, {ID: 2, Title: "Live", Published: true}})
+		case "/articles/1":
+			var payload dev.ArticleBodySchema
+			json.NewDecoder(r.Body).Decode(&payload)
+			updates = append(updates, payload)
+
+			json.NewEncoder(w).Encode(dev.ArticleVariant{Article: dev.Article{ID: 1, Published: payload.Article.Published}})
+		default:
+			t.Errorf("Unexpected request: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	vars := map[string]string{"DEV_API_KEY": "test-token", "DEV_BASE_URL": server.URL}
+
+	// select the draft, preview it, change the tags and cover, decline and
+	// then confirm publishing, go back and quit
+	input := strings.Join([]string{
+		"1", "p", "t", "go, testing", "c", "https://example.com/cover.png",
+		"P", "n", "P", "y", "b", "q",
+	}, "\n")
+
+	var stdout, stderr bytes.Buffer
+
+	code := run([]string{"articles", "tui"}, func(k string) string { return vars[k] }, strings.NewReader(input), &stdout, &stderr)
+	if code != exitOK {
+		t.Fatalf("Expected exit code %d, got %d: %s", exitOK, code, stderr.String())
+	}
+
+	out := stdout.String()
+
+	for _, s := range []string{" 1. Draft", " 2. Live", "Intro\n=====", "series: Go basics", "Saved"} {
+		if !strings.Contains(out, s) {
+			t.Errorf("Expected output to contain %q:\n%s", s, out)
+		}
+	}
+
+	if len(updates) != 1 {
+		t.Fatalf("Expected a single update, got %d", len(updates))
+	}
+
+	a := updates[0].Article
+
+	if !a.Published || !strings.Contains(a.BodyMarkdown, "published: true\n") {
+		t.Errorf("Expected the article to be published, got %+v", a)
+	}
+
+	if strings.Join(a.Tags, ",") != "go,testing" || a.MainImage != "https://example.com/cover.png" {
+		t.Errorf("Unexpected metadata: %+v", a)
+	}
+
+	if a.Series != "Go basics" {
+		t.Errorf("Expected series to be kept, got '%s'", a.Series)
+	}
+}
+
+func TestArticlesTUIClearSeries(t *testing.T) {
+	drafts := []dev.Article{
+		{ID: 1, Title: "Front matter", BodyMarkdown: "---\ntitle: Front matter\nseries: Go basics\n---\n\nbody"},
+		{ID: 2, Title: "Plain", BodyMarkdown: "body"},
+	}
+
+	var updates []dev.ArticleBodySchema
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/articles/me/unpublished", "/articles/me/all":
+			json.NewEncoder(w).Encode(drafts)
+		case "/articles/1":
+			var payload dev.ArticleBodySchema
+			json.NewDecoder(r.Body).Decode(&payload)
+			updates = append(updates, payload)
+
+			json.NewEncoder(w).Encode(dev.ArticleVariant{Article: dev.Article{ID: 1}})
+		default:
+			t.Errorf("Unexpected request: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	vars := map[string]string{"DEV_API_KEY": "test-token", "DEV_BASE_URL": server.URL}
+
+	// clear the series of both drafts, only the front matter one can be
+	input := strings.Join([]string{"1", "s", "", "w", "b", "2", "s", "", "w", "b", "q"}, "\n")
+
+	var stdout, stderr bytes.Buffer
+
+	code := run([]string{"articles", "tui"}, func(k string) string { return vars[k] }, strings.NewReader(input), &stdout, &stderr)
+	if code != exitOK {
+		t.Fatalf("Expected exit code %d, got %d: %s", exitOK, code, stderr.String())
+	}
+
+	if len(updates) != 1 {
+		t.Fatalf("Expected a single update, got %d", len(updates))
+	}
+
+	if series, ok := dev.FrontMatterField(updates[0].Article.BodyMarkdown, "series"); !ok || series != "" {
+		t.Errorf("Expected the series to be cleared in the front matter, got %q", updates[0].Article.BodyMarkdown)
+	}
+
+	out := stdout.String()
+
+	if !strings.Contains(out, "Error: the series can only be cleared in the front matter") || !strings.Contains(out, "No changes to save") {
+		t.Errorf("Expected clearing the series of the plain draft to be refused:\n%s", out)
+	}
+}
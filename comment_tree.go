@@ -0,0 +1,195 @@
+package dev
+
+import (
+	"sort"
+)
+
+// CommentVisitFunc is called for every comment visited while traversing
+// a comment tree. Returning false stops the traversal
+type CommentVisitFunc func(comment *Comment, depth int) bool
+
+type FlatComment struct {
+	Comment  *Comment
+	Depth    int
+	ParentID string
+}
+
+type CommentParticipant struct {
+	User            *User
+	Comments        int
+	TopLevel        int
+	Replies         int
+	RepliesReceived int
+	FirstCommentAt  string
+	LastCommentAt   string
+}
+
+// WalkCommentsDepthFirst visits every comment in the thread in pre-order,
+// i.e a comment is always visited before its children
+func WalkCommentsDepthFirst(comments []Comment, fn CommentVisitFunc) {
+	walkDepthFirst(comments, 0, fn)
+}
+
+func walkDepthFirst(comments []Comment, depth int, fn CommentVisitFunc) bool {
+	for i := range comments {
+		if !fn(&comments[i], depth) {
+			return false
+		}
+
+		if !walkDepthFirst(comments[i].Children, depth+1, fn) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// WalkCommentsBreadthFirst visits every comment in the thread level by level,
+// starting with the top level comments
+func WalkCommentsBreadthFirst(comments []Comment, fn CommentVisitFunc) {
+	type node struct {
+		comment *Comment
+		depth   int
+	}
+
+	queue := make([]node, 0, len(comments))
+	for i := range comments {
+		queue = append(queue, node{&comments[i], 0})
+	}
+
+	for len(queue) > 0 {
+		n := queue[0]
+		queue = queue[1:]
+
+		if !fn(n.comment, n.depth) {
+			return
+		}
+
+		for i := range n.comment.Children {
+			queue = append(queue, node{&n.comment.Children[i], n.depth + 1})
+		}
+	}
+}
+
+// FlattenComments returns every comment in the thread in depth-first order
+// alongside its depth and the id code of its parent. Top level comments
+// have an empty ParentID
+func FlattenComments(comments []Comment) []FlatComment {
+	var flat []FlatComment
+
+	var walk func(comments []Comment, depth int, parentID string)
+	walk = func(comments []Comment, depth int, parentID string) {
+		for i := range comments {
+			flat = append(flat, FlatComment{
+				Comment:  &comments[i],
+				Depth:    depth,
+				ParentID: parentID,
+			})
+
+			walk(comments[i].Children, depth+1, comments[i].IDCode)
+		}
+	}
+
+	walk(comments, 0, "")
+
+	return flat
+}
+
+// CountComments returns the total number of comments in the thread,
+// including all replies
+func CountComments(comments []Comment) int {
+	count := 0
+
+	WalkCommentsDepthFirst(comments, func(_ *Comment, _ int) bool {
+		count++
+		return true
+	})
+
+	return count
+}
+
+// CountDescendants returns the number of replies below the comment
+// at any depth
+func (c *Comment) CountDescendants() int {
+	return CountComments(c.Children)
+}
+
+// FindComment searches the thread for the comment with the given id code.
+// It returns nil if no comment matches
+func FindComment(comments []Comment, idCode string) *Comment {
+	var found *Comment
+
+	WalkCommentsDepthFirst(comments, func(comment *Comment, _ int) bool {
+		if comment.IDCode == idCode {
+			found = comment
+			return false
+		}
+
+		return true
+	})
+
+	return found
+}
+
+// GetCommentParticipation computes per-user statistics for the thread.
+// Participants are ordered by the number of comments they made, most
+// active first. Comments without a user are ignored
+func GetCommentParticipation(comments []Comment) []CommentParticipant {
+	participants := map[string]*CommentParticipant{}
+
+	get := func(user *User) *CommentParticipant {
+		p, ok := participants[user.Username]
+		if !ok {
+			p = &CommentParticipant{User: user}
+			participants[user.Username] = p
+		}
+
+		return p
+	}
+
+	for _, v := range FlattenComments(comments) {
+		if v.Comment.User == nil {
+			continue
+		}
+
+		p := get(v.Comment.User)
+		p.Comments++
+
+		if v.Depth == 0 {
+			p.TopLevel++
+		} else {
+			p.Replies++
+		}
+
+		createdAt := v.Comment.CreatedAt
+		if p.FirstCommentAt == "" || createdAt < p.FirstCommentAt {
+			p.FirstCommentAt = createdAt
+		}
+		if createdAt > p.LastCommentAt {
+			p.LastCommentAt = createdAt
+		}
+
+		for _, child := range v.Comment.Children {
+			if child.User == nil || child.User.Username == v.Comment.User.Username {
+				continue
+			}
+
+			p.RepliesReceived++
+		}
+	}
+
+	result := make([]CommentParticipant, 0, len(participants))
+	for _, p := range participants {
+		result = append(result, *p)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Comments != result[j].Comments {
+			return result[i].Comments > result[j].Comments
+		}
+
+		return result[i].User.Username < result[j].User.Username
+	})
+
+	return result
+}
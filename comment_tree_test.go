@@ -0,0 +1,145 @@
+package dev
+
+import (
+	"testing"
+)
+
+func testCommentThread() []Comment {
+	alice := &User{Username: "alice"}
+	bob := &User{Username: "bob"}
+	carol := &User{Username: "carol"}
+
+	return []Comment{
+		{
+			IDCode:    "a1",
+			CreatedAt: "2021-06-01T10:00:00Z",
+			User:      alice,
+			Children: []Comment{
+				{
+					IDCode:    "b1",
+					CreatedAt: "2021-06-01T11:00:00Z",
+					User:      bob,
+					Children: []Comment{
+						{IDCode: "a2", CreatedAt: "2021-06-02T09:00:00Z", User: alice},
+					},
+				},
+				{IDCode: "c1", CreatedAt: "2021-06-01T12:00:00Z", User: carol},
+			},
+		},
+		{IDCode: "b2", CreatedAt: "2021-06-03T08:00:00Z", User: bob},
+	}
+}
+
+func TestWalkComments(t *testing.T) {
+	comments := testCommentThread()
+
+	t.Run("depth first", func(t *testing.T) {
+		var got []string
+		WalkCommentsDepthFirst(comments, func(c *Comment, _ int) bool {
+			got = append(got, c.IDCode)
+			return true
+		})
+
+		want := []string{"a1", "b1", "a2", "c1", "b2"}
+		if !equalStrings(got, want) {
+			t.Errorf("Expected order to be %v, got %v", want, got)
+		}
+	})
+
+	t.Run("breadth first", func(t *testing.T) {
+		var got []string
+		WalkCommentsBreadthFirst(comments, func(c *Comment, _ int) bool {
+			got = append(got, c.IDCode)
+			return true
+		})
+
+		want := []string{"a1", "b2", "b1", "c1", "a2"}
+		if !equalStrings(got, want) {
+			t.Errorf("Expected order to be %v, got %v", want, got)
+		}
+	})
+
+	t.Run("stop early", func(t *testing.T) {
+		visited := 0
+		WalkCommentsDepthFirst(comments, func(c *Comment, _ int) bool {
+			visited++
+			return c.IDCode != "b1"
+		})
+
+		if visited != 2 {
+			t.Errorf("Expected traversal to stop after 2 comments, visited %d", visited)
+		}
+	})
+}
+
+func TestFlattenComments(t *testing.T) {
+	flat := FlattenComments(testCommentThread())
+
+	if len(flat) != 5 {
+		t.Fatalf("Expected 5 comments, got %d", len(flat))
+	}
+
+	if flat[2].Comment.IDCode != "a2" || flat[2].Depth != 2 || flat[2].ParentID != "b1" {
+		t.Errorf("Expected 'a2' at depth 2 with parent 'b1', got %+v", flat[2])
+	}
+
+	if flat[4].ParentID != "" || flat[4].Depth != 0 {
+		t.Errorf("Expected 'b2' to be a top level comment, got %+v", flat[4])
+	}
+}
+
+func TestCountAndFindComments(t *testing.T) {
+	comments := testCommentThread()
+
+	if n := CountComments(comments); n != 5 {
+		t.Errorf("Expected 5 comments, got %d", n)
+	}
+
+	if n := comments[0].CountDescendants(); n != 3 {
+		t.Errorf("Expected 3 descendants, got %d", n)
+	}
+
+	found := FindComment(comments, "a2")
+	if found == nil || found.IDCode != "a2" {
+		t.Fatalf("Expected to find comment 'a2', got %+v", found)
+	}
+
+	if FindComment(comments, "missing") != nil {
+		t.Errorf("Expected missing comment to return nil")
+	}
+}
+
+func TestGetCommentParticipation(t *testing.T) {
+	participants := GetCommentParticipation(testCommentThread())
+
+	if len(participants) != 3 {
+		t.Fatalf("Expected 3 participants, got %d", len(participants))
+	}
+
+	alice := participants[0]
+	if alice.User.Username != "alice" {
+		t.Fatalf("Expected first participant to be 'alice', got '%s'", alice.User.Username)
+	}
+
+	if alice.Comments != 2 || alice.TopLevel != 1 || alice.Replies != 1 || alice.RepliesReceived != 2 {
+		t.Errorf("Unexpected stats for 'alice': %+v", alice)
+	}
+
+	if alice.FirstCommentAt != "2021-06-01T10:00:00Z" || alice.LastCommentAt != "2021-06-02T09:00:00Z" {
+		t.Errorf("Unexpected comment window for 'alice': %+v", alice)
+	}
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,432 @@
+package dev
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	defaultWatchInterval   = 5 * time.Minute
+	defaultWatchMaxBackoff = time.Hour
+	watchArticlesPerPage   = 100
+)
+
+type CommentEvent struct {
+	Article  Article
+	Comment  Comment
+	ParentID string
+	Depth    int
+}
+
+type CommentWatcherOptions struct {
+	// Interval between two polls, defaults to 5 minutes
+	Interval time.Duration
+	// MaxBackoff caps the wait time after consecutive failures, defaults to 1 hour
+	MaxBackoff time.Duration
+	// RequestDelay is the pause between two comment requests in a single poll.
+	// It can be used to stay below the api rate limits on accounts with many articles
+	RequestDelay time.Duration
+	// StatePath is the file where already seen comments are persisted between runs.
+	// When empty, the state is kept in memory only
+	StatePath string
+	// EmitExisting causes the first poll to emit every comment found instead of
+	// only recording them as seen
+	EmitExisting bool
+}
+
+// CommentWatcher polls the authenticated user's published articles and emits
+// the comments and replies that were not seen in previous polls
+type CommentWatcher struct {
+	client *Client
+	opts   CommentWatcherOptions
+
+	mu      sync.Mutex
+	seen    map[int32]map[string]bool
+	seeded  bool
+	events  chan CommentEvent
+	errors  chan error
+	started bool
+}
+
+type commentWatcherState struct {
+	Articles map[string][]string `json:"articles"`
+}
+
+// NewCommentWatcher returns a watcher for comments on the authenticated user's
+// articles. If a state file exists at opts.StatePath it is loaded so that
+// comments seen in a previous run are not emitted again
+func (c *Client) NewCommentWatcher(opts CommentWatcherOptions) (*CommentWatcher, error) {
+	if opts.Interval <= 0 {
+		opts.Interval = defaultWatchInterval
+	}
+
+	if opts.MaxBackoff <= 0 {
+		opts.MaxBackoff = defaultWatchMaxBackoff
+	}
+
+	w := &CommentWatcher{
+		client: c,
+		opts:   opts,
+		seen:   map[int32]map[string]bool{},
+		events: make(chan CommentEvent, 64),
+		errors: make(chan error, 16),
+	}
+
+	if err := w.loadState(); err != nil {
+		return nil, err
+	}
+
+	return w, nil
+}
+
+// Events returns the channel new comments are emitted on. It is closed
+// when Run returns
+func (w *CommentWatcher) Events() <-chan CommentEvent {
+	return w.events
+}
+
+// Errors returns a channel of non-fatal errors encountered while polling.
+// Errors are dropped if the channel is not drained
+func (w *CommentWatcher) Errors() <-chan error {
+	return w.errors
+}
+
+// Run polls for new comments until the context is cancelled. Failed polls
+// are retried with exponential backoff, rate-limit errors back off straight
+// to the maximum wait time. Comments are only marked as seen once they were
+// sent on the events channel, so cancelling Run while it waits for a reader
+// doesn't lose them
+func (w *CommentWatcher) Run(ctx context.Context) error {
+	w.mu.Lock()
+	if w.started {
+		w.mu.Unlock()
+		return errors.New("comment watcher is already running")
+	}
+	w.started = true
+	w.mu.Unlock()
+
+	defer close(w.events)
+
+	failures := 0
+
+	for {
+		events, staged, err := w.poll(ctx)
+		if err == nil {
+			for _, e := range events {
+				select {
+				case w.events <- e:
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}
+
+			err = w.commit(staged)
+		}
+
+		wait := w.opts.Interval
+		if err != nil {
+			failures++
+			wait = w.backoff(failures, err)
+
+			select {
+			case w.errors <- err:
+			default:
+			}
+		} else {
+			failures = 0
+		}
+
+		timer := time.NewTimer(wait)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// Poll runs a single check for new comments and returns them without
+// sending them on the events channel. The comments are marked as seen and
+// the state is saved after every successful poll
+func (w *CommentWatcher) Poll(ctx context.Context) ([]CommentEvent, error) {
+	events, staged, err := w.poll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := w.commit(staged); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// poll returns the new comments and the ids to mark as seen once they
+// were handled, see commit
+func (w *CommentWatcher) poll(ctx context.Context) ([]CommentEvent, map[int32][]string, error) {
+	client := w.client.WithContext(ctx)
+
+	articles, err := w.publishedArticles(ctx, client)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	w.mu.Lock()
+	emit := w.seeded || w.opts.EmitExisting
+	w.mu.Unlock()
+
+	var events []CommentEvent
+
+	staged := map[int32][]string{}
+
+	for i, article := range articles {
+		if err := ctx.Err(); err != nil {
+			return nil, nil, err
+		}
+
+		if i > 0 && w.opts.RequestDelay > 0 {
+			if err := sleepContext(ctx, w.opts.RequestDelay); err != nil {
+				return nil, nil, err
+			}
+		}
+
+		if article.CommentsCount == 0 && !w.hasArticle(article.ID) {
+			continue
+		}
+
+		comments, err := client.GetArticleComments(article.ID)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		articleEvents, ids := w.diff(article, comments, emit)
+
+		events = append(events, articleEvents...)
+		staged[article.ID] = ids
+	}
+
+	return events, staged, nil
+}
+
+func (w *CommentWatcher) publishedArticles(ctx context.Context, client *Client) ([]Article, error) {
+	var articles []Article
+
+	for page := int32(1); ; page++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		result, err := client.GetUserPublishedArticles(
+			ArticleQueryParams{
+				Page:    page,
+				PerPage: watchArticlesPerPage,
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		articles = append(articles, result...)
+
+		if len(result) < watchArticlesPerPage {
+			return articles, nil
+		}
+	}
+}
+
+func (w *CommentWatcher) hasArticle(articleID int32) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	_, ok := w.seen[articleID]
+
+	return ok
+}
+
+// diff returns the events for comments that haven't been seen and their
+// ids. The ids are not marked as seen, see commit
+func (w *CommentWatcher) diff(article Article, comments []Comment, emit bool) ([]CommentEvent, []string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	seen := w.seen[article.ID]
+
+	var events []CommentEvent
+	var ids []string
+
+	for _, v := range FlattenComments(comments) {
+		if seen[v.Comment.IDCode] {
+			continue
+		}
+
+		ids = append(ids, v.Comment.IDCode)
+
+		if emit {
+			events = append(events, CommentEvent{
+				Article:  article,
+				Comment:  *v.Comment,
+				ParentID: v.ParentID,
+				Depth:    v.Depth,
+			})
+		}
+	}
+
+	return events, ids
+}
+
+// commit marks the comments found by a poll as seen and saves the state.
+// It is only called once every article of the poll was checked and the
+// comments were handled, so a poll that fails part way through reports the
+// same comments again on the next attempt
+func (w *CommentWatcher) commit(staged map[int32][]string) error {
+	w.mu.Lock()
+
+	for articleID, ids := range staged {
+		seen, ok := w.seen[articleID]
+		if !ok {
+			seen = map[string]bool{}
+			w.seen[articleID] = seen
+		}
+
+		for _, id := range ids {
+			seen[id] = true
+		}
+	}
+
+	w.seeded = true
+
+	w.mu.Unlock()
+
+	return w.saveState()
+}
+
+func (w *CommentWatcher) backoff(failures int, err error) time.Duration {
+	if isRateLimitError(err) {
+		return w.opts.MaxBackoff
+	}
+
+	wait := w.opts.Interval
+	for i := 1; i < failures && wait < w.opts.MaxBackoff; i++ {
+		wait *= 2
+	}
+
+	if wait > w.opts.MaxBackoff {
+		wait = w.opts.MaxBackoff
+	}
+
+	return wait
+}
+
+func (w *CommentWatcher) loadState() error {
+	if w.opts.StatePath == "" {
+		return nil
+	}
+
+	b, err := ioutil.ReadFile(w.opts.StatePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	var state commentWatcherState
+
+	if err := json.Unmarshal(b, &state); err != nil {
+		return err
+	}
+
+	for k, ids := range state.Articles {
+		articleID, err := strconv.Atoi(k)
+		if err != nil {
+			return err
+		}
+
+		seen := make(map[string]bool, len(ids))
+		for _, id := range ids {
+			seen[id] = true
+		}
+
+		w.seen[int32(articleID)] = seen
+	}
+
+	w.seeded = true
+
+	return nil
+}
+
+func (w *CommentWatcher) saveState() error {
+	if w.opts.StatePath == "" {
+		return nil
+	}
+
+	w.mu.Lock()
+	state := commentWatcherState{Articles: make(map[string][]string, len(w.seen))}
+	for articleID, seen := range w.seen {
+		ids := make([]string, 0, len(seen))
+		for id := range seen {
+			ids = append(ids, id)
+		}
+
+		state.Articles[strconv.Itoa(int(articleID))] = ids
+	}
+	w.mu.Unlock()
+
+	b, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+
+	return writeFileAtomic(w.opts.StatePath, b)
+}
+
+// isRateLimitError reports whether the error was returned because
+// the api rate limit was exceeded
+func isRateLimitError(err error) bool {
+	var apiErr *DevAPIError
+
+	return errors.As(err, &apiErr) && apiErr.code == 429
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// writeFileAtomic writes to a temporary file in the same directory and
+// renames it over the target so readers never observe a partial write
+func writeFileAtomic(path string, data []byte) error {
+	tmp, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,204 @@
+package dev
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestCommentWatcherPoll(t *testing.T) {
+	var mu sync.Mutex
+	comments := []Comment{{IDCode: "a1", User: &User{Username: "alice"}}}
+
+	c := newMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch r.URL.Path {
+		case "/articles/me/published":
+			json.NewEncoder(w).Encode([]Article{{ID: 1, Title: "Hello", CommentsCount: 1}})
+		case "/comments":
+			json.NewEncoder(w).Encode(comments)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	statePath := filepath.Join(t.TempDir(), "state.json")
+
+	w, err := c.NewCommentWatcher(CommentWatcherOptions{StatePath: statePath})
+	if err != nil {
+		t.Fatalf("Failed to create watcher: %s", err.Error())
+	}
+
+	events, err := w.Poll(context.Background())
+	if err != nil {
+		t.Fatalf("Error polling comments: %s", err.Error())
+	}
+
+	if len(events) != 0 {
+		t.Errorf("Expected first poll to only seed the state, got %d events", len(events))
+	}
+
+	mu.Lock()
+	comments[0].Children = []Comment{{IDCode: "b1", User: &User{Username: "bob"}}}
+	mu.Unlock()
+
+	// a new watcher must pick up the persisted state
+	w, err = c.NewCommentWatcher(CommentWatcherOptions{StatePath: statePath})
+	if err != nil {
+		t.Fatalf("Failed to create watcher: %s", err.Error())
+	}
+
+	events, err = w.Poll(context.Background())
+	if err != nil {
+		t.Fatalf("Error polling comments: %s", err.Error())
+	}
+
+	if len(events) != 1 {
+		t.Fatalf("Expected 1 new comment, got %d", len(events))
+	}
+
+	if events[0].Comment.IDCode != "b1" || events[0].ParentID != "a1" || events[0].Article.ID != 1 {
+		t.Errorf("Unexpected event: %+v", events[0])
+	}
+}
+
+func TestCommentWatcherPollFailsPartway(t *testing.T) {
+	var mu sync.Mutex
+	failArticle2 := false
+	comments := map[string][]Comment{
+		"1": {{IDCode: "a1"}},
+		"2": {{IDCode: "c1"}},
+	}
+
+	c := newMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch r.URL.Path {
+		case "/articles/me/published":
+			json.NewEncoder(w).Encode([]Article{{ID: 1, CommentsCount: 1}, {ID: 2, CommentsCount: 1}})
+		case "/comments":
+			articleID := r.URL.Query().Get("a_id")
+			if articleID == "2" && failArticle2 {
+				w.WriteHeader(http.StatusInternalServerError)
+				json.NewEncoder(w).Encode(map[string]interface{}{"error": "server error", "status": 500})
+				return
+			}
+
+			json.NewEncoder(w).Encode(comments[articleID])
+		}
+	}))
+
+	w, err := c.NewCommentWatcher(CommentWatcherOptions{})
+	if err != nil {
+		t.Fatalf("Failed to create watcher: %s", err.Error())
+	}
+
+	if _, err := w.Poll(context.Background()); err != nil {
+		t.Fatalf("Error polling comments: %s", err.Error())
+	}
+
+	mu.Lock()
+	comments["1"] = append(comments["1"], Comment{IDCode: "a2"})
+	failArticle2 = true
+	mu.Unlock()
+
+	if _, err := w.Poll(context.Background()); err == nil {
+		t.Fatal("Expected the poll to fail")
+	}
+
+	mu.Lock()
+	failArticle2 = false
+	mu.Unlock()
+
+	events, err := w.Poll(context.Background())
+	if err != nil {
+		t.Fatalf("Error polling comments: %s", err.Error())
+	}
+
+	if len(events) != 1 || events[0].Comment.IDCode != "a2" {
+		t.Errorf("Expected the comment found by the failed poll to be emitted, got %+v", events)
+	}
+}
+
+func TestCommentWatcherRunCancelledBeforeDelivery(t *testing.T) {
+	// more comments than the events channel buffers, so Run blocks
+	comments := make([]Comment, 70)
+	for i := range comments {
+		comments[i] = Comment{IDCode: fmt.Sprintf("c%d", i)}
+	}
+
+	c := newMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/articles/me/published":
+			json.NewEncoder(w).Encode([]Article{{ID: 1, CommentsCount: int32(len(comments))}})
+		case "/comments":
+			json.NewEncoder(w).Encode(comments)
+		}
+	}))
+
+	opts := CommentWatcherOptions{StatePath: filepath.Join(t.TempDir(), "state.json"), EmitExisting: true}
+
+	w, err := c.NewCommentWatcher(opts)
+	if err != nil {
+		t.Fatalf("Failed to create watcher: %s", err.Error())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+
+	go func() { done <- w.Run(ctx) }()
+
+	for len(w.events) < cap(w.events) {
+		time.Sleep(time.Millisecond)
+	}
+
+	cancel()
+	<-done
+
+	// the comments weren't all delivered, so none of them are seen
+	w, err = c.NewCommentWatcher(opts)
+	if err != nil {
+		t.Fatalf("Failed to create watcher: %s", err.Error())
+	}
+
+	events, err := w.Poll(context.Background())
+	if err != nil {
+		t.Fatalf("Error polling comments: %s", err.Error())
+	}
+
+	if len(events) != len(comments) {
+		t.Errorf("Expected %d comments to be emitted again, got %d", len(comments), len(events))
+	}
+}
+
+func TestCommentWatcherRunCancelsRequests(t *testing.T) {
+	c := newMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+
+	w, err := c.NewCommentWatcher(CommentWatcherOptions{})
+	if err != nil {
+		t.Fatalf("Failed to create watcher: %s", err.Error())
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	done := make(chan error)
+
+	go func() { done <- w.Run(ctx) }()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expected cancelling Run to abort the in-flight request")
+	}
+}
@@ -1,7 +1,7 @@
 package dev
 
 import (
-	"context"
+	"errors"
 	"fmt"
 
 	"github.com/google/go-querystring/query"
@@ -17,8 +17,26 @@ type Comment struct {
 }
 
 type CommentQueryParams struct {
-	ArticleID int32 `url:"a_id"`
-	PodcastID int32 `url:"p_id"`
+	ArticleID int32 `url:"a_id,omitempty"`
+	PodcastID int32 `url:"p_id,omitempty"`
+	Page      int32 `url:"page,omitempty"`
+	PerPage   int32 `url:"per_page,omitempty"`
+}
+
+const commentsPerPage = 100
+
+// Validate checks that the query targets exactly one article or
+// podcast episode
+func (q CommentQueryParams) Validate() error {
+	if q.ArticleID == 0 && q.PodcastID == 0 {
+		return errors.New("comment query must specify an article id or a podcast episode id")
+	}
+
+	if q.ArticleID != 0 && q.PodcastID != 0 {
+		return errors.New("comment query cannot specify both an article id and a podcast episode id")
+	}
+
+	return nil
 }
 
 // GetComments allows the client to retrieve all comments
@@ -26,6 +44,10 @@ type CommentQueryParams struct {
 func (c *Client) GetComments(q CommentQueryParams) ([]Comment, error) {
 	var comments []Comment
 
+	if err := q.Validate(); err != nil {
+		return nil, err
+	}
+
 	query, err := query.Values(q)
 	if err != nil {
 		return nil, err
@@ -33,7 +55,7 @@ func (c *Client) GetComments(q CommentQueryParams) ([]Comment, error) {
 
 	path := fmt.Sprintf("/comments?%s", query.Encode())
 
-	req, err := c.NewRequest(context.Background(), "GET", path, nil)
+	req, err := c.NewRequest(c.requestContext("GetComments"), "GET", path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -45,12 +67,49 @@ func (c *Client) GetComments(q CommentQueryParams) ([]Comment, error) {
 	return comments, nil
 }
 
+// GetArticleComments allows the client to retrieve all comments belonging
+// to an article. Large threads are fetched page by page
+func (c *Client) GetArticleComments(articleID int32) ([]Comment, error) {
+	return c.operation("GetArticleComments").getAllComments(CommentQueryParams{ArticleID: articleID})
+}
+
+// GetPodcastEpisodeComments allows the client to retrieve all comments
+// belonging to a podcast episode. Large threads are fetched page by page
+func (c *Client) GetPodcastEpisodeComments(episodeID int32) ([]Comment, error) {
+	return c.operation("GetPodcastEpisodeComments").getAllComments(CommentQueryParams{PodcastID: episodeID})
+}
+
+func (c *Client) getAllComments(q CommentQueryParams) ([]Comment, error) {
+	var comments []Comment
+
+	q.PerPage = commentsPerPage
+
+	for q.Page = 1; ; q.Page++ {
+		result, err := c.GetComments(q)
+		if err != nil {
+			return nil, err
+		}
+
+		// instances that don't paginate comments return the whole
+		// thread on every page
+		if len(comments) > 0 && len(result) > 0 && result[0].IDCode == comments[0].IDCode {
+			return comments, nil
+		}
+
+		comments = append(comments, result...)
+
+		if len(result) < commentsPerPage {
+			return comments, nil
+		}
+	}
+}
+
 // GetComment allows the client to retrieve a comment alongside
 // its descendants
 func (c *Client) GetComment(commentID string) (*Comment, error) {
 	path := fmt.Sprintf("/comments/%s", commentID)
 
-	req, err := c.NewRequest(context.Background(), "GET", path, nil)
+	req, err := c.NewRequest(c.requestContext("GetComment"), "GET", path, nil)
 	if err != nil {
 		return nil, err
 	}
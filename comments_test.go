@@ -1,6 +1,9 @@
 package dev
 
 import (
+	"encoding/json"
+	"fmt"
+	"net/http"
 	"os"
 	"testing"
 )
@@ -47,3 +50,51 @@ func TestGetComment(t *testing.T) {
 		t.Errorf("Expected comment id  to be '%s', got '%s'", commentID, comment.IDCode)
 	}
 }
+
+func TestCommentQueryParamsValidate(t *testing.T) {
+	if err := (CommentQueryParams{}).Validate(); err == nil {
+		t.Errorf("Expected query without a target to be invalid")
+	}
+
+	if err := (CommentQueryParams{ArticleID: 1, PodcastID: 2}).Validate(); err == nil {
+		t.Errorf("Expected query with both targets to be invalid")
+	}
+
+	if err := (CommentQueryParams{PodcastID: 2}).Validate(); err != nil {
+		t.Errorf("Expected podcast-only query to be valid, got: %s", err.Error())
+	}
+}
+
+func TestGetPodcastEpisodeComments(t *testing.T) {
+	c := newMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+
+		if _, ok := q["a_id"]; ok {
+			t.Errorf("Expected query to omit 'a_id', got '%s'", r.URL.RawQuery)
+		}
+
+		if q.Get("p_id") != "42" {
+			t.Errorf("Expected 'p_id' to be '42', got '%s'", q.Get("p_id"))
+		}
+
+		comments := []Comment{}
+		if q.Get("page") == "1" {
+			for i := 0; i < commentsPerPage; i++ {
+				comments = append(comments, Comment{IDCode: fmt.Sprintf("p1-%d", i)})
+			}
+		} else if q.Get("page") == "2" {
+			comments = append(comments, Comment{IDCode: "p2-0"})
+		}
+
+		json.NewEncoder(w).Encode(comments)
+	}))
+
+	comments, err := c.GetPodcastEpisodeComments(42)
+	if err != nil {
+		t.Fatalf("Error fetching comments: %s", err.Error())
+	}
+
+	if len(comments) != commentsPerPage+1 {
+		t.Errorf("Expected %d comments across pages, got %d", commentsPerPage+1, len(comments))
+	}
+}
@@ -20,28 +20,61 @@ func (d *DevAPIError) Error() string {
 	return fmt.Sprintf("%s: %d", d.msg, d.code)
 }
 
+// StatusCode returns the http status code reported by the api
+func (d *DevAPIError) StatusCode() int {
+	return d.code
+}
+
+// Message returns the error message reported by the api
+func (d *DevAPIError) Message() string {
+	return d.msg
+}
+
 func assertError(err error) bool {
 	t := fmt.Sprintf("%T", err)
 
 	return t == "*dev.DevAPIError"
 }
 
-func extractDevError(resp *http.Response) error {
-	var v map[string]interface{}
+// maxErrorBodyLen caps how much of a non-json error body ends up in the
+// error message, e.g. the html page of a gateway error
+const maxErrorBodyLen = 256
 
+// extractDevError reads the error reported by the api. Bodies that aren't
+// the api's json error, e.g. from a proxy, fall back to the http status
+// and the raw body
+func extractDevError(resp *http.Response) error {
 	b, err := io.ReadAll(resp.Body)
 	if err != nil {
 		return err
 	}
 
-	if err := json.Unmarshal(b, &v); err != nil {
-		return err
+	apiErr := &DevAPIError{
+		msg:  strings.TrimSpace(string(b)),
+		code: resp.StatusCode,
 	}
 
-	return &DevAPIError{
-		msg:  v["error"].(string),
-		code: int(v["status"].(float64)),
+	var v map[string]interface{}
+
+	if err := json.Unmarshal(b, &v); err == nil {
+		if msg, ok := v["error"].(string); ok {
+			apiErr.msg = msg
+		}
+
+		if status, ok := v["status"].(float64); ok {
+			apiErr.code = int(status)
+		}
+	}
+
+	if len(apiErr.msg) > maxErrorBodyLen {
+		apiErr.msg = apiErr.msg[:maxErrorBodyLen] + "..."
+	}
+
+	if apiErr.msg == "" {
+		apiErr.msg = http.StatusText(resp.StatusCode)
 	}
+
+	return apiErr
 }
 
 func parseUTCDate(t string) (time.Time, error) {
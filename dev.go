@@ -21,6 +21,18 @@ type Client struct {
 	Client  *http.Client
 	BaseUrl *url.URL
 	Token   string
+	// TokenProvider supplies the api key for each request when set,
+	// taking precedence over Token
+	TokenProvider TokenProvider
+	// Logger receives a structured log of every request when set
+	Logger Logger
+	// Debug adds a log of the redacted request and response
+	// headers and bodies
+	Debug bool
+
+	middlewares []Middleware
+	telemetry   *telemetry
+	ctx         context.Context
 }
 
 func NewClient(token string) (*Client, error) {
@@ -42,6 +54,27 @@ func NewClient(token string) (*Client, error) {
 	return c, nil
 }
 
+// NewClientWithTokenProvider returns a client that asks the provider for
+// the api key on every request, so rotated keys are picked up
+func NewClientWithTokenProvider(p TokenProvider) (*Client, error) {
+	u, err := url.Parse(BASE_URL)
+	if err != nil {
+		return nil, err
+	}
+
+	if p == nil {
+		return nil, errors.New("invalid token provider")
+	}
+
+	c := &Client{
+		Client:        http.DefaultClient,
+		BaseUrl:       u,
+		TokenProvider: p,
+	}
+
+	return c, nil
+}
+
 func NewTestClient() (*Client, error) {
 	if err := godotenv.Load(); err != nil {
 		return nil, err
@@ -52,6 +85,29 @@ func NewTestClient() (*Client, error) {
 	return NewClient(token)
 }
 
+// WithContext returns a shallow copy of the client whose requests are sent
+// with ctx, e.g. to cancel them or to record them in the caller's trace
+func (c *Client) WithContext(ctx context.Context) *Client {
+	if ctx == nil {
+		panic("nil context")
+	}
+
+	c2 := *c
+	c2.ctx = ctx
+
+	return &c2
+}
+
+// Context returns the context requests of the client are sent with. It
+// defaults to context.Background
+func (c *Client) Context() context.Context {
+	if c.ctx == nil {
+		return context.Background()
+	}
+
+	return c.ctx
+}
+
 func (c *Client) NewRequest(ctx context.Context, method, path string, payload interface{}) (*http.Request, error) {
 	var buf io.Reader
 	url := c.BaseUrl.String() + path
@@ -69,10 +125,21 @@ func (c *Client) NewRequest(ctx context.Context, method, path string, payload in
 }
 
 func (c *Client) SendHttpRequest(r *http.Request, v interface{}) error {
+	token := c.Token
+
+	if c.TokenProvider != nil {
+		t, err := c.TokenProvider.Token(r.Context())
+		if err != nil {
+			return err
+		}
+
+		token = t
+	}
+
 	r.Header.Set("Content-Type", "application/json")
-	r.Header.Set("api-key", c.Token)
+	r.Header.Set("api-key", token)
 
-	resp, err := c.Client.Do(r)
+	resp, err := c.doer().Do(r)
 	if err != nil {
 		return err
 	}
@@ -1,6 +1,9 @@
 package dev
 
 import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"os"
 	"testing"
 
@@ -46,3 +49,55 @@ func TestNewClient(t *testing.T) {
 		}
 	})
 }
+
+// newMockClient returns a client whose requests are served by the given handler
+func newMockClient(t *testing.T, handler http.Handler) *Client {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	u, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatalf("Failed to parse server url: %s", err.Error())
+	}
+
+	return &Client{
+		Client:  server.Client(),
+		BaseUrl: u,
+		Token:   "test-token",
+	}
+}
+
+func TestExtractDevError(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		body   string
+		msg    string
+		code   int
+	}{
+		{"api error", 422, `{"error": "title can't be blank", "status": 422}`, "title can't be blank", 422},
+		{"html page", 502, "<html>Bad Gateway</html>\n", "<html>Bad Gateway</html>", 502},
+		{"json without error", 500, `{"message": "oops"}`, `{"message": "oops"}`, 500},
+		{"empty body", 503, "", "Service Unavailable", 503},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			}))
+
+			_, err := c.GetAuthenticatedUser()
+
+			apiErr, ok := err.(*DevAPIError)
+			if !ok {
+				t.Fatalf("Expected a DevAPIError, got %v", err)
+			}
+
+			if apiErr.Message() != tt.msg || apiErr.StatusCode() != tt.code {
+				t.Errorf("Unexpected error: %q %d", apiErr.Message(), apiErr.StatusCode())
+			}
+		})
+	}
+}
@@ -0,0 +1,324 @@
+// Package export writes the authenticated user's DEV articles to a
+// directory that can be served by a static site generator
+package export
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	dev "github.com/Mayowa-Ojo/dev-client-go"
+)
+
+const articlesPerPage = 100
+
+type Format string
+
+const (
+	Hugo   = Format("hugo")
+	Jekyll = Format("jekyll")
+)
+
+type Options struct {
+	// Dir is the root of the site, it is created if it doesn't exist
+	Dir    string
+	Format Format
+	// IncludeDrafts exports unpublished articles as drafts
+	IncludeDrafts bool
+}
+
+// Post is a single exported article
+type Post struct {
+	Title        string
+	Slug         string
+	Date         time.Time
+	Draft        bool
+	Tags         []string
+	Description  string
+	CanonicalURL string
+	CoverImage   string
+	Series       string
+	DevURL       string
+	Body         string
+	// File is the path of the written markdown file relative to the site root
+	File string
+}
+
+type Result struct {
+	Posts []Post
+	Index string
+}
+
+var unsafeSlugRegexp = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// Articles exports every article of the authenticated user. Published article
+// bodies are fetched individually, drafts use the body from the article list
+func Articles(c *dev.Client, opts Options) (*Result, error) {
+	if opts.Dir == "" {
+		return nil, errors.New("export directory is required")
+	}
+
+	switch opts.Format {
+	case "":
+		opts.Format = Hugo
+	case Hugo, Jekyll:
+	default:
+		return nil, fmt.Errorf("unsupported export format: '%s'", opts.Format)
+	}
+
+	var articles []dev.Article
+
+	for page := int32(1); ; page++ {
+		result, err := c.GetUserArticles(
+			dev.ArticleQueryParams{
+				Page:    page,
+				PerPage: articlesPerPage,
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		articles = append(articles, result...)
+
+		if len(result) < articlesPerPage {
+			break
+		}
+	}
+
+	var posts []Post
+
+	for _, a := range articles {
+		if !a.Published && !opts.IncludeDrafts {
+			continue
+		}
+
+		body := a.BodyMarkdown
+
+		if a.Published {
+			full, err := c.GetPublishedArticleByID(strconv.Itoa(int(a.ID)))
+			if err != nil {
+				return nil, err
+			}
+
+			if full.BodyMarkdown != "" {
+				body = full.BodyMarkdown
+			}
+		}
+
+		posts = append(posts, NewPost(a, body))
+	}
+
+	return Write(posts, opts)
+}
+
+// NewPost converts an article into a post. Front matter embedded in the
+// markdown body by the DEV editor is removed and used to fill in the series
+// and any fields missing from the article
+func NewPost(a dev.Article, body string) Post {
+	series, _ := dev.FrontMatterField(body, "series")
+
+	p := Post{
+		Title:        a.Title,
+		Slug:         a.Slug,
+		Draft:        !a.Published,
+		Tags:         a.TagList,
+		Description:  a.Description,
+		CanonicalURL: a.CanonicalURL,
+		CoverImage:   a.CoverImage,
+		Series:       series,
+		DevURL:       a.URL,
+		Body:         strings.TrimSpace(dev.TrimFrontMatter(body)) + "\n",
+	}
+
+	if p.Title == "" {
+		p.Title, _ = dev.FrontMatterField(body, "title")
+	}
+
+	if p.CanonicalURL == "" {
+		p.CanonicalURL, _ = dev.FrontMatterField(body, "canonical_url")
+	}
+
+	if p.CoverImage == "" {
+		p.CoverImage, _ = dev.FrontMatterField(body, "cover_image")
+	}
+
+	if tags, _ := dev.FrontMatterField(body, "tags"); len(p.Tags) == 0 && tags != "" {
+		for _, tag := range strings.Split(tags, ",") {
+			if tag = strings.TrimSpace(tag); tag != "" {
+				p.Tags = append(p.Tags, tag)
+			}
+		}
+	}
+
+	for _, v := range []string{a.PublishedTimestamp, a.PublishedAt, a.CreatedAt} {
+		if t, err := time.Parse(time.RFC3339, v); err == nil {
+			p.Date = t
+			break
+		}
+	}
+
+	if p.Slug == "" {
+		p.Slug = slugify(p.Title)
+	}
+
+	if p.Slug == "" {
+		p.Slug = strconv.Itoa(int(a.ID))
+	}
+
+	return p
+}
+
+// Write writes the posts and an index page to the site directory
+func Write(posts []Post, opts Options) (*Result, error) {
+	if opts.Format == "" {
+		opts.Format = Hugo
+	}
+
+	sort.SliceStable(posts, func(i, j int) bool {
+		return posts[i].Date.After(posts[j].Date)
+	})
+
+	var postsDir, indexFile string
+
+	switch opts.Format {
+	case Jekyll:
+		postsDir = "_posts"
+		indexFile = "index.md"
+	default:
+		postsDir = filepath.Join("content", "posts")
+		indexFile = filepath.Join("content", "posts", "_index.md")
+	}
+
+	if err := os.MkdirAll(filepath.Join(opts.Dir, postsDir), 0755); err != nil {
+		return nil, err
+	}
+
+	result := &Result{Index: indexFile}
+
+	for _, p := range posts {
+		// slugs become file names, so they can't be allowed to leave
+		// the posts directory
+		slug := slugify(p.Slug)
+		if slug == "" {
+			return nil, fmt.Errorf("post '%s' has an invalid slug: '%s'", p.Title, p.Slug)
+		}
+
+		p.Slug = slug
+
+		name := p.Slug + ".md"
+		if opts.Format == Jekyll {
+			if p.Date.IsZero() {
+				return nil, fmt.Errorf("post '%s' has no date, jekyll requires one", p.Slug)
+			}
+
+			// jekyll requires the date in the file name
+			name = p.Date.Format("2006-01-02") + "-" + name
+		}
+
+		p.File = filepath.Join(postsDir, name)
+
+		content := frontMatter(p, opts.Format) + "\n" + p.Body
+
+		if err := ioutil.WriteFile(filepath.Join(opts.Dir, p.File), []byte(content), 0644); err != nil {
+			return nil, err
+		}
+
+		result.Posts = append(result.Posts, p)
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(opts.Dir, indexFile), []byte(index(result.Posts, opts.Format)), 0644); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// slugify lowercases s and replaces anything but letters, digits and
+// dashes with dashes
+func slugify(s string) string {
+	return strings.Trim(unsafeSlugRegexp.ReplaceAllString(strings.ToLower(s), "-"), "-")
+}
+
+func frontMatter(p Post, format Format) string {
+	var b strings.Builder
+
+	b.WriteString("---\n")
+
+	if format == Jekyll {
+		b.WriteString("layout: post\n")
+	}
+
+	writeField(&b, "title", p.Title)
+
+	if !p.Date.IsZero() {
+		fmt.Fprintf(&b, "date: %s\n", p.Date.UTC().Format(time.RFC3339))
+	}
+
+	if p.Draft {
+		b.WriteString("draft: true\n")
+		if format == Jekyll {
+			b.WriteString("published: false\n")
+		}
+	}
+
+	if len(p.Tags) > 0 {
+		quoted := make([]string, len(p.Tags))
+		for i, tag := range p.Tags {
+			quoted[i] = strconv.Quote(tag)
+		}
+
+		fmt.Fprintf(&b, "tags: [%s]\n", strings.Join(quoted, ", "))
+	}
+
+	writeField(&b, "description", p.Description)
+	writeField(&b, "canonical_url", p.CanonicalURL)
+	writeField(&b, "cover_image", p.CoverImage)
+	writeField(&b, "series", p.Series)
+	writeField(&b, "dev_url", p.DevURL)
+
+	b.WriteString("---\n")
+
+	return b.String()
+}
+
+// writeField writes a quoted yaml scalar, empty values are skipped
+func writeField(b *strings.Builder, key, value string) {
+	if value == "" {
+		return
+	}
+
+	fmt.Fprintf(b, "%s: %s\n", key, strconv.Quote(value))
+}
+
+func index(posts []Post, format Format) string {
+	var b strings.Builder
+
+	b.WriteString("---\n")
+	if format == Jekyll {
+		b.WriteString("layout: home\n")
+	}
+	b.WriteString("title: \"Articles\"\n---\n\n")
+
+	for _, p := range posts {
+		if p.Draft {
+			continue
+		}
+
+		link := p.Slug + "/"
+		if format == Jekyll {
+			link = "{% post_url " + strings.TrimSuffix(filepath.Base(p.File), ".md") + " %}"
+		}
+
+		fmt.Fprintf(&b, "- [%s](%s) - %s\n", p.Title, link, p.Date.Format("2006-01-02"))
+	}
+
+	return b.String()
+}
@@ -0,0 +1,128 @@
+package export
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	dev "github.com/Mayowa-Ojo/dev-client-go"
+)
+
+func TestArticles(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/articles/me/all":
+			json.NewEncoder(w).Encode([]dev.Article{
+				{
+					ID:           1,
+					Title:        "Structs in Go",
+					Slug:         "structs-in-go-1a2b",
+					Published:    true,
+					PublishedAt:  "2021-06-01T10:00:00Z",
+					TagList:      []string{"go"},
+					CanonicalURL: "https://example.com/structs",
+					CoverImage:   "https://example.com/cover.png",
+				},
+				{ID: 2, Title: "Work in progress", BodyMarkdown: "draft body", CreatedAt: "2021-06-05T10:00:00Z"},
+			})
+		case "/articles/1":
+			json.NewEncoder(w).Encode(dev.ArticleVariant{
+				Article: dev.Article{
+					BodyMarkdown: "---\ntitle: Structs in Go\nseries: Go basics\n---\n\n## Embedding\n",
+				},
+			})
+		default:
+			t.Errorf("Unexpected request: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	u, _ := url.Parse(server.URL)
+	c := &dev.Client{Client: server.Client(), BaseUrl: u, Token: "test-token"}
+
+	dir := t.TempDir()
+
+	result, err := Articles(c, Options{Dir: dir, Format: Jekyll, IncludeDrafts: true})
+	if err != nil {
+		t.Fatalf("Error exporting articles: %s", err.Error())
+	}
+
+	if len(result.Posts) != 2 {
+		t.Fatalf("Expected 2 posts, got %d", len(result.Posts))
+	}
+
+	b, err := ioutil.ReadFile(filepath.Join(dir, "_posts", "2021-06-01-structs-in-go-1a2b.md"))
+	if err != nil {
+		t.Fatalf("Expected post file to be written: %s", err.Error())
+	}
+
+	want := "---\n" +
+		"layout: post\n" +
+		"title: \"Structs in Go\"\n" +
+		"date: 2021-06-01T10:00:00Z\n" +
+		"tags: [\"go\"]\n" +
+		"canonical_url: \"https://example.com/structs\"\n" +
+		"cover_image: \"https://example.com/cover.png\"\n" +
+		"series: \"Go basics\"\n" +
+		"---\n\n" +
+		"## Embedding\n"
+
+	if string(b) != want {
+		t.Errorf("Unexpected post:\n%s\nwant:\n%s", b, want)
+	}
+
+	index, err := ioutil.ReadFile(filepath.Join(dir, "index.md"))
+	if err != nil {
+		t.Fatalf("Expected index to be written: %s", err.Error())
+	}
+
+	if !strings.Contains(string(index), "- [Structs in Go]({% post_url 2021-06-01-structs-in-go-1a2b %}) - 2021-06-01") {
+		t.Errorf("Unexpected index:\n%s", index)
+	}
+
+	if strings.Contains(string(index), "Work in progress") {
+		t.Errorf("Expected drafts to be left out of the index")
+	}
+}
+
+func TestWriteSanitizesSlugs(t *testing.T) {
+	dir := t.TempDir()
+
+	result, err := Write([]Post{{Title: "Escape", Slug: "../../Escape Me"}}, Options{Dir: dir})
+	if err != nil {
+		t.Fatalf("Error writing posts: %s", err.Error())
+	}
+
+	if file := result.Posts[0].File; file != filepath.Join("content", "posts", "escape-me.md") {
+		t.Errorf("Unexpected post file: %s", file)
+	}
+
+	if _, err := Write([]Post{{Title: "Dots", Slug: "../.."}}, Options{Dir: dir}); err == nil {
+		t.Error("Expected a slug without any valid characters to be rejected")
+	}
+}
+
+func TestWriteJekyllRequiresDate(t *testing.T) {
+	if _, err := Write([]Post{{Title: "Undated", Slug: "undated"}}, Options{Dir: t.TempDir(), Format: Jekyll}); err == nil {
+		t.Error("Expected a jekyll post without a date to be rejected")
+	}
+}
+
+func TestNewPostFrontMatter(t *testing.T) {
+	body := "\r\n---\r\ntitle: \"Hello\"\r\ntags: go, testing\r\nseries: Go basics\r\n---\r\n\r\n## Hi\r\n"
+
+	p := NewPost(dev.Article{ID: 1}, body)
+
+	if p.Title != "Hello" || p.Series != "Go basics" || strings.Join(p.Tags, ",") != "go,testing" {
+		t.Errorf("Unexpected front matter fields: %+v", p)
+	}
+
+	if p.Body != "## Hi\n" || p.Slug != "hello" {
+		t.Errorf("Unexpected body or slug: %q %q", p.Body, p.Slug)
+	}
+}
@@ -0,0 +1,400 @@
+package feed
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"sort"
+	"strconv"
+	"time"
+
+	dev "github.com/Mayowa-Ojo/dev-client-go"
+)
+
+const jsonFeedVersion = "https://jsonfeed.org/version/1.1"
+
+// ArticleOptions holds the metadata of an article feed
+type ArticleOptions struct {
+	Title       string
+	Description string
+	// Link is the home page the feed belongs to
+	Link string
+	// FeedURL is the public url of the generated feed
+	FeedURL  string
+	Language string
+	// BaseURL is used to resolve article and author paths, defaults to https://dev.to
+	BaseURL string
+	// Now is used for the build date when there are no articles, defaults to time.Now
+	Now func() time.Time
+	// Body returns the html of articles without a body, e.g. those from
+	// GetUserPublishedArticles or GetOrganizationArticles, whose list
+	// responses leave out body_html. See BodyFetcher. When nil, those
+	// articles have no content
+	Body func(a dev.Article) (string, error)
+}
+
+// BodyFetcher returns a Body function that fetches each article from the
+// api to get its html
+func BodyFetcher(c *dev.Client) func(a dev.Article) (string, error) {
+	return func(a dev.Article) (string, error) {
+		full, err := c.GetPublishedArticleByID(strconv.Itoa(int(a.ID)))
+		if err != nil {
+			return "", err
+		}
+
+		return full.BodyHTML, nil
+	}
+}
+
+type entry struct {
+	ID          string
+	URL         string
+	Title       string
+	Summary     string
+	ContentHTML string
+	Image       string
+	Tags        []string
+	AuthorName  string
+	AuthorURL   string
+	Avatar      string
+	PublishedAt time.Time
+	UpdatedAt   time.Time
+}
+
+// ArticlesRSS renders the articles as an RSS 2.0 feed. The article html is
+// included with content:encoded and tags are used as categories
+func ArticlesRSS(articles []dev.Article, opts ArticleOptions) ([]byte, error) {
+	entries, updated, err := prepare(articles, &opts)
+	if err != nil {
+		return nil, err
+	}
+
+	doc := rss{
+		ContentNS: contentNamespace,
+		DCNS:      dcNamespace,
+		Channel: rssChannel{
+			Title:         opts.Title,
+			Link:          opts.Link,
+			Description:   opts.Description,
+			Language:      opts.Language,
+			LastBuildDate: rfc822(updated),
+		},
+	}
+
+	if opts.FeedURL != "" {
+		doc.AtomNS = atomNamespace
+		doc.Channel.AtomLink = &rssAtomLink{Href: opts.FeedURL, Rel: "self", Type: "application/rss+xml"}
+	}
+
+	for _, e := range entries {
+		item := rssItem{
+			Title:       e.Title,
+			Link:        e.URL,
+			GUID:        rssGUID{Value: e.ID, IsPermaLink: e.ID == e.URL},
+			Description: e.Summary,
+			Creator:     e.AuthorName,
+			Categories:  e.Tags,
+			PubDate:     rfc822(e.PublishedAt),
+		}
+
+		if e.ContentHTML != "" {
+			item.ContentEncoded = &cdata{Value: e.ContentHTML}
+		}
+
+		doc.Channel.Items = append(doc.Channel.Items, item)
+	}
+
+	return marshalRSS(doc)
+}
+
+type atomFeed struct {
+	XMLName  xml.Name    `xml:"feed"`
+	XMLNS    string      `xml:"xmlns,attr"`
+	Lang     string      `xml:"xml:lang,attr,omitempty"`
+	ID       string      `xml:"id"`
+	Title    string      `xml:"title"`
+	Subtitle string      `xml:"subtitle,omitempty"`
+	Updated  string      `xml:"updated"`
+	Links    []atomLink  `xml:"link"`
+	Entries  []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+	Type string `xml:"type,attr,omitempty"`
+}
+
+type atomEntry struct {
+	ID         string         `xml:"id"`
+	Title      string         `xml:"title"`
+	Link       atomLink       `xml:"link"`
+	Published  string         `xml:"published,omitempty"`
+	Updated    string         `xml:"updated"`
+	Author     *atomAuthor    `xml:"author,omitempty"`
+	Categories []atomCategory `xml:"category,omitempty"`
+	Summary    string         `xml:"summary,omitempty"`
+	Content    *atomContent   `xml:"content,omitempty"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+	URI  string `xml:"uri,omitempty"`
+}
+
+type atomCategory struct {
+	Term string `xml:"term,attr"`
+}
+
+type atomContent struct {
+	Type  string `xml:"type,attr"`
+	Value string `xml:",chardata"`
+}
+
+// ArticlesAtom renders the articles as an Atom 1.0 feed
+func ArticlesAtom(articles []dev.Article, opts ArticleOptions) ([]byte, error) {
+	entries, updated, err := prepare(articles, &opts)
+	if err != nil {
+		return nil, err
+	}
+
+	id := opts.FeedURL
+	if id == "" {
+		id = opts.Link
+	}
+
+	doc := atomFeed{
+		XMLNS:    atomNamespace,
+		Lang:     opts.Language,
+		ID:       id,
+		Title:    opts.Title,
+		Subtitle: opts.Description,
+		Updated:  updated.UTC().Format(time.RFC3339),
+		Links:    []atomLink{{Href: opts.Link, Rel: "alternate", Type: "text/html"}},
+	}
+
+	if opts.FeedURL != "" {
+		doc.Links = append(doc.Links, atomLink{Href: opts.FeedURL, Rel: "self", Type: "application/atom+xml"})
+	}
+
+	for _, e := range entries {
+		item := atomEntry{
+			ID:      e.ID,
+			Title:   e.Title,
+			Link:    atomLink{Href: e.URL, Rel: "alternate", Type: "text/html"},
+			Updated: e.UpdatedAt.UTC().Format(time.RFC3339),
+			Summary: e.Summary,
+		}
+
+		if !e.PublishedAt.IsZero() {
+			item.Published = e.PublishedAt.UTC().Format(time.RFC3339)
+		}
+
+		if e.AuthorName != "" {
+			item.Author = &atomAuthor{Name: e.AuthorName, URI: e.AuthorURL}
+		}
+
+		for _, tag := range e.Tags {
+			item.Categories = append(item.Categories, atomCategory{Term: tag})
+		}
+
+		if e.ContentHTML != "" {
+			item.Content = &atomContent{Type: "html", Value: e.ContentHTML}
+		}
+
+		doc.Entries = append(doc.Entries, item)
+	}
+
+	b, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte(xml.Header), b...), nil
+}
+
+type jsonFeed struct {
+	Version     string         `json:"version"`
+	Title       string         `json:"title"`
+	HomePageURL string         `json:"home_page_url,omitempty"`
+	FeedURL     string         `json:"feed_url,omitempty"`
+	Description string         `json:"description,omitempty"`
+	Language    string         `json:"language,omitempty"`
+	Items       []jsonFeedItem `json:"items"`
+}
+
+type jsonFeedItem struct {
+	ID            string           `json:"id"`
+	URL           string           `json:"url,omitempty"`
+	Title         string           `json:"title,omitempty"`
+	ContentHTML   string           `json:"content_html,omitempty"`
+	ContentText   string           `json:"content_text,omitempty"`
+	Summary       string           `json:"summary,omitempty"`
+	Image         string           `json:"image,omitempty"`
+	DatePublished string           `json:"date_published,omitempty"`
+	DateModified  string           `json:"date_modified,omitempty"`
+	Authors       []jsonFeedAuthor `json:"authors,omitempty"`
+	Tags          []string         `json:"tags,omitempty"`
+}
+
+type jsonFeedAuthor struct {
+	Name   string `json:"name,omitempty"`
+	URL    string `json:"url,omitempty"`
+	Avatar string `json:"avatar,omitempty"`
+}
+
+// ArticlesJSONFeed renders the articles as a JSON Feed 1.1 document
+func ArticlesJSONFeed(articles []dev.Article, opts ArticleOptions) ([]byte, error) {
+	entries, _, err := prepare(articles, &opts)
+	if err != nil {
+		return nil, err
+	}
+
+	doc := jsonFeed{
+		Version:     jsonFeedVersion,
+		Title:       opts.Title,
+		HomePageURL: opts.Link,
+		FeedURL:     opts.FeedURL,
+		Description: opts.Description,
+		Language:    opts.Language,
+		Items:       []jsonFeedItem{},
+	}
+
+	for _, e := range entries {
+		item := jsonFeedItem{
+			ID:           e.ID,
+			URL:          e.URL,
+			Title:        e.Title,
+			ContentHTML:  e.ContentHTML,
+			Summary:      e.Summary,
+			Image:        e.Image,
+			DateModified: e.UpdatedAt.UTC().Format(time.RFC3339),
+			Tags:         e.Tags,
+		}
+
+		// items must have content, fall back to the description
+		if item.ContentHTML == "" {
+			item.ContentText = e.Summary
+		}
+
+		if !e.PublishedAt.IsZero() {
+			item.DatePublished = e.PublishedAt.UTC().Format(time.RFC3339)
+		}
+
+		if e.AuthorName != "" {
+			item.Authors = []jsonFeedAuthor{{Name: e.AuthorName, URL: e.AuthorURL, Avatar: e.Avatar}}
+		}
+
+		doc.Items = append(doc.Items, item)
+	}
+
+	return json.MarshalIndent(doc, "", "  ")
+}
+
+// prepare fills in option defaults and converts the articles to feed
+// entries ordered newest first. It also returns the feed update time
+func prepare(articles []dev.Article, opts *ArticleOptions) ([]entry, time.Time, error) {
+	if opts.BaseURL == "" {
+		opts.BaseURL = DefaultBaseURL
+	}
+
+	if opts.Now == nil {
+		opts.Now = time.Now
+	}
+
+	if opts.Link == "" {
+		opts.Link = opts.BaseURL
+	}
+
+	if opts.Title == "" {
+		return nil, time.Time{}, errors.New("feed title is required")
+	}
+
+	if opts.Description == "" {
+		opts.Description = opts.Title
+	}
+
+	entries := make([]entry, 0, len(articles))
+
+	var updated time.Time
+
+	for _, a := range articles {
+		e := entry{
+			URL:         a.CanonicalURL,
+			Title:       a.Title,
+			Summary:     a.Description,
+			ContentHTML: a.BodyHTML,
+			Image:       a.CoverImage,
+			Tags:        a.TagList,
+			PublishedAt: parseTime(a.PublishedTimestamp, a.PublishedAt),
+		}
+
+		if e.ContentHTML == "" && opts.Body != nil {
+			body, err := opts.Body(a)
+			if err != nil {
+				return nil, time.Time{}, err
+			}
+
+			e.ContentHTML = body
+		}
+
+		if e.URL == "" {
+			e.URL = a.URL
+		}
+
+		if e.URL == "" {
+			e.URL = absoluteURL(opts.BaseURL, a.Path)
+		}
+
+		e.ID = e.URL
+
+		e.UpdatedAt = parseTime(a.EditedAt)
+		if e.UpdatedAt.IsZero() {
+			e.UpdatedAt = e.PublishedAt
+		}
+
+		if e.UpdatedAt.IsZero() {
+			e.UpdatedAt = opts.Now()
+		}
+
+		if a.User != nil {
+			e.AuthorName = a.User.Name
+			if e.AuthorName == "" {
+				e.AuthorName = a.User.Username
+			}
+
+			if a.User.Username != "" {
+				e.AuthorURL = absoluteURL(opts.BaseURL, a.User.Username)
+			}
+
+			e.Avatar = a.User.ProfileImage
+		}
+
+		if e.UpdatedAt.After(updated) {
+			updated = e.UpdatedAt
+		}
+
+		entries = append(entries, e)
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].PublishedAt.After(entries[j].PublishedAt)
+	})
+
+	if updated.IsZero() {
+		updated = opts.Now()
+	}
+
+	return entries, updated, nil
+}
+
+// parseTime returns the first value that parses as an RFC 3339 timestamp
+func parseTime(values ...string) time.Time {
+	for _, v := range values {
+		if t, err := time.Parse(time.RFC3339, v); err == nil {
+			return t
+		}
+	}
+
+	return time.Time{}
+}
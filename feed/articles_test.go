@@ -0,0 +1,182 @@
+package feed
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	dev "github.com/Mayowa-Ojo/dev-client-go"
+)
+
+func testArticles() []dev.Article {
+	user := &dev.User{Name: "Ben Halpern", Username: "ben", ProfileImage: "https://example.com/ben.png"}
+
+	return []dev.Article{
+		{
+			Title:        "Older post",
+			Description:  "The older one",
+			Path:         "/ben/older-post",
+			URL:          "https://dev.to/ben/older-post",
+			PublishedAt:  "2021-06-01T10:00:00Z",
+			TagList:      []string{"go"},
+			User:         user,
+			BodyHTML:     "<p>Hello <strong>world</strong></p>",
+			CanonicalURL: "https://example.com/older-post",
+		},
+		{
+			Title:       "Newer post",
+			Description: "The newer one",
+			Path:        "/ben/newer-post",
+			URL:         "https://dev.to/ben/newer-post",
+			PublishedAt: "2021-06-10T10:00:00Z",
+			EditedAt:    "2021-06-12T10:00:00Z",
+			TagList:     []string{"rust", "webdev"},
+			User:        user,
+		},
+	}
+}
+
+var testOptions = ArticleOptions{
+	Title:   "ACME engineering",
+	Link:    "https://example.com",
+	FeedURL: "https://example.com/feed",
+}
+
+func TestArticlesRSS(t *testing.T) {
+	b, err := ArticlesRSS(testArticles(), testOptions)
+	if err != nil {
+		t.Fatalf("Error generating feed: %s", err.Error())
+	}
+
+	out := string(b)
+
+	for _, want := range []string{
+		`<content:encoded><![CDATA[<p>Hello <strong>world</strong></p>]]></content:encoded>`,
+		`<guid isPermaLink="true">https://example.com/older-post</guid>`,
+		`<category>webdev</category>`,
+		`<dc:creator>Ben Halpern</dc:creator>`,
+		`<lastBuildDate>Sat, 12 Jun 2021 10:00:00 +0000</lastBuildDate>`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected feed to contain %s\n%s", want, out)
+		}
+	}
+
+	if strings.Index(out, "Newer post") > strings.Index(out, "Older post") {
+		t.Errorf("Expected newest article first")
+	}
+}
+
+func TestArticlesAtom(t *testing.T) {
+	b, err := ArticlesAtom(testArticles(), testOptions)
+	if err != nil {
+		t.Fatalf("Error generating feed: %s", err.Error())
+	}
+
+	var doc struct {
+		ID      string `xml:"id"`
+		Updated string `xml:"updated"`
+		Entries []struct {
+			ID      string `xml:"id"`
+			Content string `xml:"content"`
+			Author  struct {
+				URI string `xml:"uri"`
+			} `xml:"author"`
+		} `xml:"entry"`
+	}
+
+	if err := xml.Unmarshal(b, &doc); err != nil {
+		t.Fatalf("Expected feed to be valid xml: %s", err.Error())
+	}
+
+	if doc.ID != "https://example.com/feed" || doc.Updated != "2021-06-12T10:00:00Z" {
+		t.Errorf("Unexpected feed metadata: %s %s", doc.ID, doc.Updated)
+	}
+
+	if len(doc.Entries) != 2 || doc.Entries[1].Content != "<p>Hello <strong>world</strong></p>" {
+		t.Errorf("Unexpected entries: %+v", doc.Entries)
+	}
+
+	if doc.Entries[0].Author.URI != "https://dev.to/ben" {
+		t.Errorf("Expected author uri to be 'https://dev.to/ben', got '%s'", doc.Entries[0].Author.URI)
+	}
+}
+
+func TestArticlesJSONFeed(t *testing.T) {
+	b, err := ArticlesJSONFeed(testArticles(), testOptions)
+	if err != nil {
+		t.Fatalf("Error generating feed: %s", err.Error())
+	}
+
+	var doc jsonFeed
+	if err := json.Unmarshal(b, &doc); err != nil {
+		t.Fatalf("Expected feed to be valid json: %s", err.Error())
+	}
+
+	if doc.Version != jsonFeedVersion || len(doc.Items) != 2 {
+		t.Fatalf("Unexpected feed: %+v", doc)
+	}
+
+	newer := doc.Items[0]
+	if newer.ID != "https://dev.to/ben/newer-post" || newer.ContentText != "The newer one" || len(newer.Tags) != 2 {
+		t.Errorf("Unexpected item: %+v", newer)
+	}
+
+	if newer.DateModified != "2021-06-12T10:00:00Z" || newer.Authors[0].Avatar != "https://example.com/ben.png" {
+		t.Errorf("Unexpected item dates or author: %+v", newer)
+	}
+}
+
+func TestArticlesFetchBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/articles/2" {
+			t.Errorf("Unexpected request: %s", r.URL.Path)
+		}
+
+		json.NewEncoder(w).Encode(dev.ArticleVariant{Article: dev.Article{ID: 2, BodyHTML: "<p>Fetched</p>"}})
+	}))
+	defer server.Close()
+
+	u, _ := url.Parse(server.URL)
+	c := &dev.Client{Client: server.Client(), BaseUrl: u, Token: "test-token"}
+
+	// list responses have the markdown but no html
+	articles := []dev.Article{{
+		ID:           2,
+		Title:        "Listed post",
+		Description:  "From a list",
+		URL:          "https://dev.to/ben/listed-post",
+		PublishedAt:  "2021-06-10T10:00:00Z",
+		BodyMarkdown: "Fetched",
+	}}
+
+	opts := testOptions
+	opts.Body = BodyFetcher(c)
+
+	b, err := ArticlesJSONFeed(articles, opts)
+	if err != nil {
+		t.Fatalf("Error generating feed: %s", err.Error())
+	}
+
+	var doc jsonFeed
+	if err := json.Unmarshal(b, &doc); err != nil {
+		t.Fatalf("Expected feed to be valid json: %s", err.Error())
+	}
+
+	if len(doc.Items) != 1 || doc.Items[0].ContentHTML != "<p>Fetched</p>" {
+		t.Errorf("Expected the fetched html as content, got %+v", doc.Items)
+	}
+
+	b, err = ArticlesRSS(articles, opts)
+	if err != nil {
+		t.Fatalf("Error generating feed: %s", err.Error())
+	}
+
+	if !strings.Contains(string(b), "<content:encoded><![CDATA[<p>Fetched</p>]]></content:encoded>") {
+		t.Errorf("Expected content:encoded to have the fetched html:\n%s", b)
+	}
+}
@@ -0,0 +1,174 @@
+package feed
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	dev "github.com/Mayowa-Ojo/dev-client-go"
+)
+
+// PodcastChannel holds the podcast metadata of the feed. Empty fields are
+// filled in from the podcast the episodes belong to
+type PodcastChannel struct {
+	Title       string
+	Link        string
+	Description string
+	Language    string
+	Author      string
+	OwnerName   string
+	OwnerEmail  string
+	ImageURL    string
+	Category    string
+	Explicit    bool
+}
+
+// Enclosure is the media file of an episode
+type Enclosure struct {
+	URL    string
+	Length int64
+	Type   string
+}
+
+// EpisodeDetails holds episode information that is not part
+// of the DEV api response
+type EpisodeDetails struct {
+	Enclosure   *Enclosure
+	PublishedAt time.Time
+	Duration    time.Duration
+	Description string
+}
+
+type PodcastOptions struct {
+	// BaseURL is used to resolve episode paths, defaults to https://dev.to
+	BaseURL string
+	// FeedURL is the public url of the generated feed, used for the
+	// atom self link
+	FeedURL string
+	Channel PodcastChannel
+	// Details returns the extra information for an episode, such as the
+	// audio file the podcast apps download. It may be nil
+	Details func(episode dev.PodcastEpisode) EpisodeDetails
+	// Now is used for the build date, defaults to time.Now
+	Now func() time.Time
+}
+
+// PodcastRSS renders the episodes as an RSS 2.0 feed with iTunes tags
+func PodcastRSS(episodes []dev.PodcastEpisode, opts PodcastOptions) ([]byte, error) {
+	if opts.BaseURL == "" {
+		opts.BaseURL = DefaultBaseURL
+	}
+
+	if opts.Now == nil {
+		opts.Now = time.Now
+	}
+
+	ch := opts.Channel
+
+	if len(episodes) > 0 {
+		podcast := episodes[0].Podcast
+
+		if ch.Title == "" {
+			ch.Title = podcast.Title
+		}
+
+		if ch.Link == "" && podcast.Slug != "" {
+			ch.Link = absoluteURL(opts.BaseURL, podcast.Slug)
+		}
+
+		if ch.ImageURL == "" {
+			ch.ImageURL = podcast.ImageURL
+		}
+	}
+
+	if ch.Description == "" {
+		ch.Description = ch.Title
+	}
+
+	if ch.Title == "" || ch.Link == "" {
+		return nil, errors.New("podcast feed requires a channel title and link")
+	}
+
+	doc := rss{
+		ItunesNS: itunesNamespace,
+		Channel: rssChannel{
+			Title:         ch.Title,
+			Link:          ch.Link,
+			Description:   ch.Description,
+			Language:      ch.Language,
+			LastBuildDate: rfc822(opts.Now()),
+			ItunesAuthor:  ch.Author,
+			ItunesSummary: ch.Description,
+		},
+	}
+
+	doc.Channel.ItunesExplicit = "false"
+	if ch.Explicit {
+		doc.Channel.ItunesExplicit = "true"
+	}
+
+	if opts.FeedURL != "" {
+		doc.AtomNS = atomNamespace
+		doc.Channel.AtomLink = &rssAtomLink{Href: opts.FeedURL, Rel: "self", Type: "application/rss+xml"}
+	}
+
+	if ch.ImageURL != "" {
+		doc.Channel.Image = &rssImage{URL: ch.ImageURL, Title: ch.Title, Link: ch.Link}
+		doc.Channel.ItunesImage = &itunesImage{Href: ch.ImageURL}
+	}
+
+	if ch.OwnerName != "" || ch.OwnerEmail != "" {
+		doc.Channel.ItunesOwner = &itunesOwner{Name: ch.OwnerName, Email: ch.OwnerEmail}
+	}
+
+	if ch.Category != "" {
+		doc.Channel.ItunesCategory = &itunesCategory{Text: ch.Category}
+	}
+
+	for _, ep := range episodes {
+		link := absoluteURL(opts.BaseURL, ep.Path)
+
+		item := rssItem{
+			Title:             ep.Title,
+			Link:              link,
+			GUID:              rssGUID{Value: link, IsPermaLink: true},
+			ItunesTitle:       ep.Title,
+			ItunesEpisodeType: "full",
+		}
+
+		image := ep.ImageURL
+		if image == "" {
+			image = ep.Podcast.ImageURL
+		}
+
+		if image != "" {
+			item.ItunesImage = &itunesImage{Href: image}
+		}
+
+		if opts.Details != nil {
+			details := opts.Details(ep)
+
+			item.Description = details.Description
+			item.PubDate = rfc822(details.PublishedAt)
+
+			if details.Duration > 0 {
+				item.ItunesDuration = formatDuration(details.Duration)
+			}
+
+			if e := details.Enclosure; e != nil {
+				item.Enclosure = &rssEnclosure{URL: e.URL, Length: e.Length, Type: e.Type}
+			}
+		}
+
+		doc.Channel.Items = append(doc.Channel.Items, item)
+	}
+
+	return marshalRSS(doc)
+}
+
+// formatDuration formats the duration as HH:MM:SS as expected by itunes:duration
+func formatDuration(d time.Duration) string {
+	seconds := int(d.Round(time.Second) / time.Second)
+
+	return fmt.Sprintf("%02d:%02d:%02d", seconds/3600, seconds%3600/60, seconds%60)
+}
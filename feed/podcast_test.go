@@ -0,0 +1,78 @@
+package feed
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+
+	dev "github.com/Mayowa-Ojo/dev-client-go"
+)
+
+func TestPodcastRSS(t *testing.T) {
+	podcast := dev.Podcast{Title: "CodeNewbie", Slug: "codenewbie", ImageURL: "https://example.com/cover.png"}
+
+	episodes := []dev.PodcastEpisode{
+		{ID: 1, Title: "Episode 1", Path: "/codenewbie/episode-1", Podcast: podcast},
+		{ID: 2, Title: "Episode 2", Path: "/codenewbie/episode-2", ImageURL: "https://example.com/ep2.png", Podcast: podcast},
+	}
+
+	published := time.Date(2021, 6, 1, 10, 0, 0, 0, time.UTC)
+
+	b, err := PodcastRSS(episodes, PodcastOptions{
+		FeedURL: "https://example.com/podcast.xml",
+		Channel: PodcastChannel{Author: "ACME", Category: "Technology"},
+		Details: func(ep dev.PodcastEpisode) EpisodeDetails {
+			return EpisodeDetails{
+				PublishedAt: published,
+				Duration:    62*time.Minute + 5*time.Second,
+				Enclosure: &Enclosure{
+					URL:    "https://example.com/" + ep.Path + ".mp3",
+					Length: 1024,
+					Type:   "audio/mpeg",
+				},
+			}
+		},
+	})
+	if err != nil {
+		t.Fatalf("Error generating feed: %s", err.Error())
+	}
+
+	out := string(b)
+
+	for _, want := range []string{
+		`xmlns:itunes="http://www.itunes.com/dtds/podcast-1.0.dtd"`,
+		`<link>https://dev.to/codenewbie</link>`,
+		`<itunes:image href="https://example.com/cover.png"></itunes:image>`,
+		`<guid isPermaLink="true">https://dev.to/codenewbie/episode-1</guid>`,
+		`<itunes:duration>01:02:05</itunes:duration>`,
+		`<pubDate>Tue, 01 Jun 2021 10:00:00 +0000</pubDate>`,
+		`<itunes:image href="https://example.com/ep2.png"></itunes:image>`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected feed to contain %s\n%s", want, out)
+		}
+	}
+
+	var doc struct {
+		Channel struct {
+			Items []struct {
+				Title string `xml:"title"`
+			} `xml:"item"`
+		} `xml:"channel"`
+	}
+
+	if err := xml.Unmarshal(b, &doc); err != nil {
+		t.Fatalf("Expected feed to be valid xml: %s", err.Error())
+	}
+
+	if len(doc.Channel.Items) != 2 {
+		t.Errorf("Expected 2 items, got %d", len(doc.Channel.Items))
+	}
+}
+
+func TestPodcastRSSRequiresChannel(t *testing.T) {
+	if _, err := PodcastRSS(nil, PodcastOptions{}); err == nil {
+		t.Errorf("Expected an error for a feed without title and link")
+	}
+}
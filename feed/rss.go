@@ -0,0 +1,128 @@
+// Package feed renders DEV articles and podcast episodes as syndication feeds
+package feed
+
+import (
+	"encoding/xml"
+	"strings"
+	"time"
+)
+
+const (
+	DefaultBaseURL = "https://dev.to"
+
+	itunesNamespace  = "http://www.itunes.com/dtds/podcast-1.0.dtd"
+	contentNamespace = "http://purl.org/rss/1.0/modules/content/"
+	dcNamespace      = "http://purl.org/dc/elements/1.1/"
+	atomNamespace    = "http://www.w3.org/2005/Atom"
+)
+
+type rss struct {
+	XMLName   xml.Name   `xml:"rss"`
+	Version   string     `xml:"version,attr"`
+	ItunesNS  string     `xml:"xmlns:itunes,attr,omitempty"`
+	ContentNS string     `xml:"xmlns:content,attr,omitempty"`
+	DCNS      string     `xml:"xmlns:dc,attr,omitempty"`
+	AtomNS    string     `xml:"xmlns:atom,attr,omitempty"`
+	Channel   rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title          string          `xml:"title"`
+	Link           string          `xml:"link"`
+	Description    string          `xml:"description"`
+	Language       string          `xml:"language,omitempty"`
+	LastBuildDate  string          `xml:"lastBuildDate,omitempty"`
+	AtomLink       *rssAtomLink    `xml:"atom:link,omitempty"`
+	Image          *rssImage       `xml:"image,omitempty"`
+	ItunesAuthor   string          `xml:"itunes:author,omitempty"`
+	ItunesSummary  string          `xml:"itunes:summary,omitempty"`
+	ItunesExplicit string          `xml:"itunes:explicit,omitempty"`
+	ItunesImage    *itunesImage    `xml:"itunes:image,omitempty"`
+	ItunesOwner    *itunesOwner    `xml:"itunes:owner,omitempty"`
+	ItunesCategory *itunesCategory `xml:"itunes:category,omitempty"`
+	Items          []rssItem       `xml:"item"`
+}
+
+type rssAtomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr"`
+	Type string `xml:"type,attr"`
+}
+
+type rssImage struct {
+	URL   string `xml:"url"`
+	Title string `xml:"title"`
+	Link  string `xml:"link"`
+}
+
+type rssItem struct {
+	Title             string        `xml:"title"`
+	Link              string        `xml:"link"`
+	GUID              rssGUID       `xml:"guid"`
+	Description       string        `xml:"description,omitempty"`
+	ContentEncoded    *cdata        `xml:"content:encoded,omitempty"`
+	Creator           string        `xml:"dc:creator,omitempty"`
+	Categories        []string      `xml:"category,omitempty"`
+	PubDate           string        `xml:"pubDate,omitempty"`
+	Enclosure         *rssEnclosure `xml:"enclosure,omitempty"`
+	ItunesTitle       string        `xml:"itunes:title,omitempty"`
+	ItunesImage       *itunesImage  `xml:"itunes:image,omitempty"`
+	ItunesDuration    string        `xml:"itunes:duration,omitempty"`
+	ItunesEpisodeType string        `xml:"itunes:episodeType,omitempty"`
+}
+
+type rssGUID struct {
+	Value       string `xml:",chardata"`
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+}
+
+type rssEnclosure struct {
+	URL    string `xml:"url,attr"`
+	Length int64  `xml:"length,attr"`
+	Type   string `xml:"type,attr"`
+}
+
+type cdata struct {
+	Value string `xml:",cdata"`
+}
+
+type itunesImage struct {
+	Href string `xml:"href,attr"`
+}
+
+type itunesOwner struct {
+	Name  string `xml:"itunes:name,omitempty"`
+	Email string `xml:"itunes:email,omitempty"`
+}
+
+type itunesCategory struct {
+	Text string `xml:"text,attr"`
+}
+
+func marshalRSS(doc rss) ([]byte, error) {
+	doc.Version = "2.0"
+
+	b, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte(xml.Header), b...), nil
+}
+
+// absoluteURL resolves a DEV path such as /ben/my-post against the base url
+func absoluteURL(baseURL, path string) string {
+	if strings.HasPrefix(path, "http://") || strings.HasPrefix(path, "https://") || path == "" {
+		return path
+	}
+
+	return strings.TrimRight(baseURL, "/") + "/" + strings.TrimLeft(path, "/")
+}
+
+func rfc822(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.UTC().Format(time.RFC1123Z)
+}
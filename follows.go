@@ -0,0 +1,177 @@
+package dev
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/go-querystring/query"
+)
+
+type FollowQueryParams struct {
+	Page    int32 `url:"page,omitempty"`
+	PerPage int32 `url:"per_page,omitempty"`
+}
+
+const followedTagsPerPage = 100
+
+type FollowUsersBodySchema struct {
+	Users []FollowUser `json:"users"`
+}
+
+type FollowUser struct {
+	ID int32 `json:"id"`
+}
+
+type FollowUsersResult struct {
+	Outcome string `json:"outcome"`
+}
+
+// TagFollowDiff describes the changes needed to go from the
+// currently followed tags to a desired set
+type TagFollowDiff struct {
+	Follow   []string
+	Unfollow []string
+	Keep     []string
+}
+
+// FollowTagFunc follows (or unfollows when follow is false) a single tag
+type FollowTagFunc func(tag string, follow bool) error
+
+// ListFollowedTags allows the client to retrieve a page of the tags they follow
+func (c *Client) ListFollowedTags(q FollowQueryParams) ([]Tag, error) {
+	var tags []Tag
+
+	query, err := query.Values(q)
+	if err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("/follows/tags?%s", query.Encode())
+
+	req, err := c.NewRequest(c.requestContext("ListFollowedTags"), "GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.SendHttpRequest(req, &tags); err != nil {
+		return nil, err
+	}
+
+	return tags, nil
+}
+
+// FollowUsers allows the client to follow the users with the given ids.
+// The api does not support unfollowing users
+func (c *Client) FollowUsers(userIDs []int32) (*FollowUsersResult, error) {
+	if len(userIDs) == 0 {
+		return nil, errors.New("at least one user id is required")
+	}
+
+	path := "/follows"
+
+	payload := FollowUsersBodySchema{}
+	for _, id := range userIDs {
+		payload.Users = append(payload.Users, FollowUser{ID: id})
+	}
+
+	req, err := c.NewRequest(c.requestContext("FollowUsers"), "POST", path, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	result := new(FollowUsersResult)
+
+	if err := c.SendHttpRequest(req, &result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// DiffFollowedTags compares the currently followed tags with the desired
+// tag names. Names are compared case-insensitively and the results are sorted
+func DiffFollowedTags(current []Tag, desired []string) TagFollowDiff {
+	followed := map[string]bool{}
+	for _, t := range current {
+		followed[strings.ToLower(t.Name)] = true
+	}
+
+	wanted := map[string]bool{}
+	for _, name := range desired {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name != "" {
+			wanted[name] = true
+		}
+	}
+
+	var diff TagFollowDiff
+
+	for name := range wanted {
+		if followed[name] {
+			diff.Keep = append(diff.Keep, name)
+		} else {
+			diff.Follow = append(diff.Follow, name)
+		}
+	}
+
+	for name := range followed {
+		if !wanted[name] {
+			diff.Unfollow = append(diff.Unfollow, name)
+		}
+	}
+
+	sort.Strings(diff.Follow)
+	sort.Strings(diff.Unfollow)
+	sort.Strings(diff.Keep)
+
+	return diff
+}
+
+// SyncFollowedTags diffs the desired tags against the tags the client currently
+// follows and applies the changes with the given function. The DEV api does not
+// expose an endpoint to follow tags, so callers provide the mechanism. When apply
+// is nil the diff is returned without making changes
+func (c *Client) SyncFollowedTags(desired []string, apply FollowTagFunc) (*TagFollowDiff, error) {
+	var current []Tag
+
+	for page := int32(1); ; page++ {
+		tags, err := c.ListFollowedTags(FollowQueryParams{Page: page, PerPage: followedTagsPerPage})
+		if err != nil {
+			return nil, err
+		}
+
+		// instances that don't paginate followed tags return every tag
+		// on every page
+		if len(current) > 0 && len(tags) > 0 && tags[0].Name == current[0].Name {
+			break
+		}
+
+		current = append(current, tags...)
+
+		if len(tags) < followedTagsPerPage {
+			break
+		}
+	}
+
+	diff := DiffFollowedTags(current, desired)
+
+	if apply == nil {
+		return &diff, nil
+	}
+
+	for _, tag := range diff.Follow {
+		if err := apply(tag, true); err != nil {
+			return &diff, err
+		}
+	}
+
+	for _, tag := range diff.Unfollow {
+		if err := apply(tag, false); err != nil {
+			return &diff, err
+		}
+	}
+
+	return &diff, nil
+}
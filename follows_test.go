@@ -0,0 +1,123 @@
+package dev
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestListFollowedTags(t *testing.T) {
+	c, err := NewTestClient()
+	if err != nil {
+		t.Errorf("Failed to create TestClient: %s", err.Error())
+	}
+
+	tags, err := c.ListFollowedTags(
+		FollowQueryParams{
+			Page:    1,
+			PerPage: 5,
+		},
+	)
+
+	if err != nil {
+		t.Errorf("Error fetching tags: %s", err.Error())
+	}
+
+	if len(tags) > 5 {
+		t.Errorf("Expected at most 5 tags, instead got: '%d'", len(tags))
+	}
+}
+
+func TestDiffFollowedTags(t *testing.T) {
+	current := []Tag{{Name: "go"}, {Name: "rust"}, {Name: "javascript"}}
+
+	diff := DiffFollowedTags(current, []string{"Go", "webdev", " rust ", ""})
+
+	if !equalStrings(diff.Follow, []string{"webdev"}) {
+		t.Errorf("Expected to follow [webdev], got %v", diff.Follow)
+	}
+
+	if !equalStrings(diff.Unfollow, []string{"javascript"}) {
+		t.Errorf("Expected to unfollow [javascript], got %v", diff.Unfollow)
+	}
+
+	if !equalStrings(diff.Keep, []string{"go", "rust"}) {
+		t.Errorf("Expected to keep [go rust], got %v", diff.Keep)
+	}
+}
+
+func TestSyncFollowedTags(t *testing.T) {
+	c := newMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/follows/tags" {
+			t.Errorf("Unexpected request: %s", r.URL.Path)
+		}
+
+		// a full first page, the followed tags continue on the second
+		switch r.URL.Query().Get("page") {
+		case "1":
+			tags := make([]Tag, followedTagsPerPage)
+			for i := range tags {
+				tags[i] = Tag{Name: fmt.Sprintf("tag%d", i)}
+			}
+
+			tags[0].Name = "go"
+
+			json.NewEncoder(w).Encode(tags)
+		case "2":
+			json.NewEncoder(w).Encode([]Tag{{Name: "php"}})
+		default:
+			t.Errorf("Unexpected page: %s", r.URL.RawQuery)
+		}
+	}))
+
+	applied := map[string]bool{}
+
+	_, err := c.SyncFollowedTags([]string{"go", "devops"}, func(tag string, follow bool) error {
+		applied[tag] = follow
+		return nil
+	})
+
+	if err != nil {
+		t.Fatalf("Error syncing tags: %s", err.Error())
+	}
+
+	if follow, ok := applied["php"]; !ok || follow || !applied["devops"] {
+		t.Errorf("Expected to follow 'devops' and unfollow 'php', got %v", applied)
+	}
+
+	if _, ok := applied["go"]; ok || len(applied) != followedTagsPerPage+1 {
+		t.Errorf("Expected every followed tag but 'go' to be unfollowed, got %d changes", len(applied))
+	}
+}
+
+func TestSyncFollowedTagsUnpaginated(t *testing.T) {
+	tags := make([]Tag, followedTagsPerPage+20)
+	for i := range tags {
+		tags[i] = Tag{ID: int64(i + 1), Name: fmt.Sprintf("tag%d", i)}
+	}
+
+	requests := 0
+
+	c := newMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		if requests > 5 {
+			t.Error("Expected the repeated page to stop the sync")
+			json.NewEncoder(w).Encode([]Tag{})
+			return
+		}
+
+		// the page parameters are ignored
+		json.NewEncoder(w).Encode(tags)
+	}))
+
+	diff, err := c.SyncFollowedTags([]string{"tag0"}, nil)
+	if err != nil {
+		t.Fatalf("Error syncing tags: %s", err.Error())
+	}
+
+	if len(diff.Keep) != 1 || len(diff.Unfollow) != len(tags)-1 {
+		t.Errorf("Unexpected diff: keep %v, unfollow %d tags", diff.Keep, len(diff.Unfollow))
+	}
+}
@@ -0,0 +1,77 @@
+package dev
+
+import (
+	"strings"
+)
+
+// frontMatterBounds returns the offsets of the front matter lines of the
+// markdown, excluding the --- delimiters
+func frontMatterBounds(markdown string) (int, int, bool) {
+	start := len(markdown) - len(strings.TrimLeft(markdown, "\r\n"))
+	if !strings.HasPrefix(markdown[start:], "---") {
+		return 0, 0, false
+	}
+
+	start += len("---")
+
+	end := strings.Index(markdown[start:], "\n---")
+	if end < 0 {
+		return 0, 0, false
+	}
+
+	// skip the line break after the opening delimiter
+	first := start + strings.IndexByte(markdown[start:start+end+1], '\n') + 1
+
+	return first, start + end, true
+}
+
+// FrontMatterField returns the value of a key in the front matter of an
+// article body. DEV gives these values precedence over the json fields
+func FrontMatterField(markdown, key string) (string, bool) {
+	start, end, ok := frontMatterBounds(markdown)
+	if !ok || start > end {
+		return "", false
+	}
+
+	for _, line := range strings.Split(markdown[start:end], "\n") {
+		parts := strings.SplitN(line, ":", 2)
+		if len(parts) == 2 && strings.TrimSpace(parts[0]) == key {
+			return strings.Trim(strings.TrimSpace(parts[1]), `"'`), true
+		}
+	}
+
+	return "", false
+}
+
+// SetFrontMatterField sets the value of a key that already exists in the
+// front matter of an article body. It returns false, and the body as is,
+// when the body has no front matter or the key isn't set there
+func SetFrontMatterField(markdown, key, value string) (string, bool) {
+	start, end, ok := frontMatterBounds(markdown)
+	if !ok || start > end {
+		return markdown, false
+	}
+
+	lines := strings.Split(markdown[start:end], "\n")
+
+	for i, line := range lines {
+		parts := strings.SplitN(line, ":", 2)
+		if len(parts) == 2 && strings.TrimSpace(parts[0]) == key {
+			lines[i] = key + ": " + value
+
+			return markdown[:start] + strings.Join(lines, "\n") + markdown[end:], true
+		}
+	}
+
+	return markdown, false
+}
+
+// TrimFrontMatter returns the body of an article without its front matter
+func TrimFrontMatter(markdown string) string {
+	_, end, ok := frontMatterBounds(markdown)
+	if !ok {
+		return markdown
+	}
+
+	return strings.TrimLeft(markdown[end+len("\n---"):], "\r\n")
+}
@@ -0,0 +1,36 @@
+package dev
+
+import "testing"
+
+func TestFrontMatterField(t *testing.T) {
+	body := "---\ntitle: Hello\npublished: true\ncanonical_url: \"https://example.com/hello\"\n---\n\nbody"
+
+	if v, ok := FrontMatterField(body, "canonical_url"); !ok || v != "https://example.com/hello" {
+		t.Errorf("Unexpected canonical_url: '%s'", v)
+	}
+
+	got, ok := SetFrontMatterField(body, "published", "false")
+	if !ok || got != "---\ntitle: Hello\npublished: false\ncanonical_url: \"https://example.com/hello\"\n---\n\nbody" {
+		t.Errorf("Unexpected body: %q", got)
+	}
+
+	if _, ok := SetFrontMatterField(body, "series", "Go"); ok {
+		t.Error("Expected a missing key not to be set")
+	}
+
+	for _, body := range []string{"no front matter", "---\n---\nbody", "---\ntitle: unterminated"} {
+		if _, ok := SetFrontMatterField(body, "title", "x"); ok {
+			t.Errorf("Expected %q not to be changed", body)
+		}
+	}
+}
+
+func TestTrimFrontMatter(t *testing.T) {
+	if got := TrimFrontMatter("---\ntitle: Hello\n---\n\n# Hello"); got != "# Hello" {
+		t.Errorf("Unexpected body: %q", got)
+	}
+
+	if got := TrimFrontMatter("# Hello"); got != "# Hello" {
+		t.Errorf("Unexpected body: %q", got)
+	}
+}
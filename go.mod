@@ -1,8 +1,20 @@
 module github.com/Mayowa-Ojo/dev-client-go
 
-go 1.16
+go 1.21
 
 require (
-	github.com/google/go-querystring v1.1.0 // indirect
-	github.com/joho/godotenv v1.4.0 // indirect
+	github.com/google/go-querystring v1.1.0
+	github.com/joho/godotenv v1.4.0
+	go.opentelemetry.io/otel v1.24.0
+	go.opentelemetry.io/otel/metric v1.24.0
+	go.opentelemetry.io/otel/sdk v1.24.0
+	go.opentelemetry.io/otel/sdk/metric v1.24.0
+	go.opentelemetry.io/otel/trace v1.24.0
+	golang.org/x/net v0.33.0
+)
+
+require (
+	github.com/go-logr/logr v1.4.1 // indirect
+	github.com/go-logr/stdr v1.2.2 // indirect
+	golang.org/x/sys v0.28.0 // indirect
 )
@@ -0,0 +1,430 @@
+package dev
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// preformatted text uses a private-use rune for line breaks while the
+// document is being assembled so that whitespace normalization leaves
+// code blocks untouched
+const preNewline = "\uE000"
+
+var (
+	blankLinesRegexp = regexp.MustCompile(`\n{3,}`)
+	whitespaceRegexp = regexp.MustCompile(`[ \t\r\n\f]+`)
+	// list items are kept tight, paragraphs inside them are not separated
+	listBlankLinesRegexp = regexp.MustCompile(`\n{2,}`)
+	backtickRunRegexp    = regexp.MustCompile("`+")
+
+	markdownEscaper = strings.NewReplacer(
+		`\`, `\\`, "*", `\*`, "_", `\_`, "`", "\\`",
+		"[", `\[`, "]", `\]`, "<", `\<`, ">", `\>`,
+	)
+)
+
+// HTMLToMarkdown converts html rendered by DEV (article and comment bodies)
+// into markdown. Code blocks are fenced with their language, mentions are kept
+// as @username and liquid embeds are turned back into {% embed %} tags
+func HTMLToMarkdown(s string) (string, error) {
+	return convertHTML(s, true)
+}
+
+// HTMLToText converts html rendered by DEV into plain text suitable for
+// notifications. Links are followed by their url and embeds are replaced
+// by the url of the embedded content
+func HTMLToText(s string) (string, error) {
+	return convertHTML(s, false)
+}
+
+// Markdown returns the comment body converted to markdown
+func (c *Comment) Markdown() (string, error) {
+	return HTMLToMarkdown(c.BodyHTML)
+}
+
+// Text returns the comment body converted to plain text
+func (c *Comment) Text() (string, error) {
+	return HTMLToText(c.BodyHTML)
+}
+
+// Markdown returns the rendered article body converted to markdown. Liquid
+// tags and front matter of the original markdown aren't recovered
+func (a *Article) Markdown() (string, error) {
+	return HTMLToMarkdown(a.BodyHTML)
+}
+
+// Text returns the rendered article body converted to plain text
+func (a *Article) Text() (string, error) {
+	return HTMLToText(a.BodyHTML)
+}
+
+func convertHTML(s string, markdown bool) (string, error) {
+	context := &html.Node{
+		Type:     html.ElementNode,
+		Data:     "body",
+		DataAtom: atom.Body,
+	}
+
+	nodes, err := html.ParseFragment(strings.NewReader(s), context)
+	if err != nil {
+		return "", err
+	}
+
+	conv := htmlConverter{markdown: markdown}
+
+	var b strings.Builder
+	for _, n := range nodes {
+		b.WriteString(conv.convert(n))
+	}
+
+	out := b.String()
+
+	lines := strings.Split(out, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " \t")
+	}
+	out = strings.Join(lines, "\n")
+
+	out = blankLinesRegexp.ReplaceAllString(out, "\n\n")
+	out = strings.ReplaceAll(out, preNewline, "\n")
+
+	return strings.TrimSpace(out), nil
+}
+
+type htmlConverter struct {
+	markdown bool
+}
+
+func (h htmlConverter) convert(n *html.Node) string {
+	switch n.Type {
+	case html.TextNode:
+		text := whitespaceRegexp.ReplaceAllString(n.Data, " ")
+		if h.markdown {
+			// text that looks like markup must stay text, e.g. an
+			// escaped <script> tag in a comment
+			text = markdownEscaper.Replace(text)
+		}
+		return text
+	case html.ElementNode:
+		return h.element(n)
+	case html.DocumentNode:
+		return h.children(n)
+	}
+
+	return ""
+}
+
+func (h htmlConverter) children(n *html.Node) string {
+	var b strings.Builder
+
+	for child := n.FirstChild; child != nil; child = child.NextSibling {
+		b.WriteString(h.convert(child))
+	}
+
+	return b.String()
+}
+
+func (h htmlConverter) element(n *html.Node) string {
+	if isLiquidEmbed(n) {
+		return h.embed(n)
+	}
+
+	if hasClass(n, "highlight__panel") {
+		return ""
+	}
+
+	switch n.DataAtom {
+	case atom.Script, atom.Style, atom.Svg, atom.Button, atom.Head:
+		return ""
+	case atom.Br:
+		if h.markdown {
+			return "  \n"
+		}
+		return "\n"
+	case atom.Hr:
+		if h.markdown {
+			return block("---")
+		}
+		return block("")
+	case atom.P, atom.Div, atom.Section, atom.Article, atom.Figure, atom.Figcaption, atom.Table, atom.Tr:
+		return block(strings.TrimSpace(h.children(n)))
+	case atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6:
+		text := strings.TrimSpace(h.children(n))
+		if h.markdown {
+			level := int(n.Data[1] - '0')
+			text = strings.Repeat("#", level) + " " + text
+		}
+		return block(text)
+	case atom.Strong, atom.B:
+		return h.wrap(n, "**")
+	case atom.Em, atom.I:
+		// underscores don't emphasize inside words
+		return h.wrap(n, "*")
+	case atom.Del, atom.S, atom.Strike:
+		return h.wrap(n, "~~")
+	case atom.Code:
+		return h.code(n)
+	case atom.Pre:
+		return h.pre(n)
+	case atom.Blockquote:
+		return h.blockquote(n)
+	case atom.Ul, atom.Ol:
+		return h.list(n)
+	case atom.A:
+		return h.link(n)
+	case atom.Img:
+		return h.image(n)
+	case atom.Td, atom.Th:
+		return strings.TrimSpace(h.children(n)) + " "
+	}
+
+	return h.children(n)
+}
+
+func (h htmlConverter) wrap(n *html.Node, marker string) string {
+	text := h.children(n)
+	if strings.TrimSpace(text) == "" {
+		return text
+	}
+
+	if !h.markdown {
+		return text
+	}
+
+	// markers must hug the text, so surrounding spaces are moved outside
+	trimmed := strings.TrimSpace(text)
+	leading := text[:strings.Index(text, trimmed)]
+	trailing := text[len(leading)+len(trimmed):]
+
+	return leading + marker + trimmed + marker + trailing
+}
+
+// code converts inline code. Its text isn't escaped, so the span is
+// fenced with more backticks than any run of them in the code
+func (h htmlConverter) code(n *html.Node) string {
+	text := whitespaceRegexp.ReplaceAllString(rawText(n), " ")
+	if !h.markdown || strings.TrimSpace(text) == "" {
+		return text
+	}
+
+	longest := 0
+	for _, run := range backtickRunRegexp.FindAllString(text, -1) {
+		if len(run) > longest {
+			longest = len(run)
+		}
+	}
+
+	fence := strings.Repeat("`", longest+1)
+
+	if strings.HasPrefix(text, "`") || strings.HasSuffix(text, "`") {
+		text = " " + text + " "
+	}
+
+	return fence + text + fence
+}
+
+func (h htmlConverter) pre(n *html.Node) string {
+	code := strings.Trim(rawText(n), "\n")
+	code = strings.ReplaceAll(code, "\n", preNewline)
+
+	if !h.markdown {
+		return block(code)
+	}
+
+	lang := ""
+	for _, class := range strings.Fields(attr(n, "class")) {
+		if class != "highlight" && class != "plaintext" {
+			lang = class
+			break
+		}
+	}
+
+	return block("```" + lang + preNewline + code + preNewline + "```")
+}
+
+func (h htmlConverter) blockquote(n *html.Node) string {
+	text := strings.TrimSpace(h.children(n))
+	text = blankLinesRegexp.ReplaceAllString(text, "\n\n")
+
+	if !h.markdown {
+		return block(text)
+	}
+
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight("> "+line, " ")
+	}
+
+	return block(strings.Join(lines, "\n"))
+}
+
+func (h htmlConverter) list(n *html.Node) string {
+	var items []string
+
+	index := 1
+	for child := n.FirstChild; child != nil; child = child.NextSibling {
+		if child.DataAtom != atom.Li {
+			continue
+		}
+
+		marker := "- "
+		if n.DataAtom == atom.Ol {
+			marker = strconv.Itoa(index) + ". "
+			index++
+		}
+
+		text := strings.TrimSpace(h.children(child))
+		text = listBlankLinesRegexp.ReplaceAllString(text, "\n")
+
+		// nested content is indented to line up with the item text
+		indent := strings.Repeat(" ", len(marker))
+		lines := strings.Split(text, "\n")
+		for i := 1; i < len(lines); i++ {
+			if lines[i] != "" {
+				lines[i] = indent + lines[i]
+			}
+		}
+
+		items = append(items, marker+strings.Join(lines, "\n"))
+	}
+
+	return block(strings.Join(items, "\n"))
+}
+
+func (h htmlConverter) link(n *html.Node) string {
+	href := attr(n, "href")
+	text := h.children(n)
+	trimmed := strings.TrimSpace(text)
+
+	// heading anchors and mentions carry no useful link
+	if trimmed == "" || href == "" || strings.HasPrefix(href, "#") || hasClass(n, "mentioned-user") {
+		return text
+	}
+
+	if h.markdown {
+		return "[" + trimmed + "](" + href + ")"
+	}
+
+	if trimmed == href {
+		return href
+	}
+
+	return trimmed + " (" + href + ")"
+}
+
+func (h htmlConverter) image(n *html.Node) string {
+	alt := attr(n, "alt")
+
+	if !h.markdown {
+		return alt
+	}
+
+	return "![" + alt + "](" + attr(n, "src") + ")"
+}
+
+func (h htmlConverter) embed(n *html.Node) string {
+	url := embedURL(n)
+	if url == "" {
+		return block(strings.TrimSpace(h.children(n)))
+	}
+
+	if h.markdown {
+		return block("{% embed " + url + " %}")
+	}
+
+	return block(url)
+}
+
+// isLiquidEmbed reports whether the node is the rendered output of a liquid
+// tag such as {% embed %}, {% github %} or {% youtube %}
+func isLiquidEmbed(n *html.Node) bool {
+	if n.DataAtom == atom.Iframe {
+		return true
+	}
+
+	for _, class := range strings.Fields(attr(n, "class")) {
+		if strings.HasPrefix(class, "ltag") {
+			return true
+		}
+	}
+
+	return false
+}
+
+func embedURL(n *html.Node) string {
+	if n.DataAtom == atom.Iframe {
+		return attr(n, "src")
+	}
+
+	var link, frame string
+
+	var find func(n *html.Node)
+	find = func(n *html.Node) {
+		for child := n.FirstChild; child != nil; child = child.NextSibling {
+			if child.DataAtom == atom.Iframe && frame == "" {
+				frame = attr(child, "src")
+			}
+
+			if child.DataAtom == atom.A && link == "" {
+				if href := attr(child, "href"); !strings.HasPrefix(href, "#") {
+					link = href
+				}
+			}
+
+			find(child)
+		}
+	}
+
+	find(n)
+
+	if link != "" {
+		return link
+	}
+
+	return frame
+}
+
+func block(s string) string {
+	return "\n\n" + s + "\n\n"
+}
+
+func rawText(n *html.Node) string {
+	if n.Type == html.TextNode {
+		return n.Data
+	}
+
+	if n.Type == html.ElementNode && n.DataAtom == atom.Br {
+		return "\n"
+	}
+
+	var b strings.Builder
+	for child := n.FirstChild; child != nil; child = child.NextSibling {
+		b.WriteString(rawText(child))
+	}
+
+	return b.String()
+}
+
+func attr(n *html.Node, key string) string {
+	for _, a := range n.Attr {
+		if a.Key == key {
+			return a.Val
+		}
+	}
+
+	return ""
+}
+
+func hasClass(n *html.Node, class string) bool {
+	for _, c := range strings.Fields(attr(n, "class")) {
+		if c == class {
+			return true
+		}
+	}
+
+	return false
+}
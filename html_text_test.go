@@ -0,0 +1,119 @@
+package dev
+
+import (
+	"testing"
+)
+
+const testCommentHTML = `<p>Thanks <a class="mentioned-user" href="https://dev.to/ben">@ben</a>, see <a href="https://go.dev">the docs</a> and <strong>this</strong>:</p>
+
+<div class="highlight js-code-highlight">
+<pre class="highlight go"><code><span class="k">func</span> <span class="n">main</span><span class="p">()</span> <span class="p">{</span>
+
+<span class="p">}</span>
+</code></pre>
+<div class="highlight__panel js-actions-panel"><div class="highlight__panel-action js-fullscreen-code-action">Enter fullscreen mode</div></div>
+</div>
+
+<ul>
+<li>one</li>
+<li>two <code>x</code>
+</li>
+</ul>
+
+<div class="ltag__link"><a href="https://dev.to/ben/hello" class="ltag__link__link"><div class="ltag__link__content"><h2>Hello</h2></div></a></div>
+
+<iframe width="710" height="399" src="https://www.youtube.com/embed/abc123" allowfullscreen loading="lazy"></iframe>`
+
+func TestHTMLToMarkdown(t *testing.T) {
+	got, err := HTMLToMarkdown(testCommentHTML)
+	if err != nil {
+		t.Fatalf("Error converting html: %s", err.Error())
+	}
+
+	want := "Thanks @ben, see [the docs](https://go.dev) and **this**:\n\n" +
+		"```go\nfunc main() {\n\n}\n```\n\n" +
+		"- one\n- two `x`\n\n" +
+		"{% embed https://dev.to/ben/hello %}\n\n" +
+		"{% embed https://www.youtube.com/embed/abc123 %}"
+
+	if got != want {
+		t.Errorf("Unexpected markdown:\n%s\n\nwant:\n%s", got, want)
+	}
+}
+
+func TestCommentText(t *testing.T) {
+	comment := Comment{BodyHTML: testCommentHTML}
+
+	got, err := comment.Text()
+	if err != nil {
+		t.Fatalf("Error converting html: %s", err.Error())
+	}
+
+	want := "Thanks @ben, see the docs (https://go.dev) and this:\n\n" +
+		"func main() {\n\n}\n\n" +
+		"- one\n- two x\n\n" +
+		"https://dev.to/ben/hello\n\n" +
+		"https://www.youtube.com/embed/abc123"
+
+	if got != want {
+		t.Errorf("Unexpected text:\n%s\n\nwant:\n%s", got, want)
+	}
+}
+
+func TestArticleMarkdownAndText(t *testing.T) {
+	article := Article{BodyHTML: `<h2>Intro</h2>
+<p>Read <a href="https://go.dev">the docs</a>.</p>`}
+
+	md, err := article.Markdown()
+	if err != nil {
+		t.Fatalf("Error converting html: %s", err.Error())
+	}
+
+	if md != "## Intro\n\nRead [the docs](https://go.dev)." {
+		t.Errorf("Unexpected markdown: %q", md)
+	}
+
+	text, err := article.Text()
+	if err != nil {
+		t.Fatalf("Error converting html: %s", err.Error())
+	}
+
+	if text != "Intro\n\nRead the docs (https://go.dev)." {
+		t.Errorf("Unexpected text: %q", text)
+	}
+}
+
+func TestHTMLToMarkdownEscaping(t *testing.T) {
+	tests := []struct {
+		html string
+		want string
+	}{
+		{`<p>&lt;script&gt;alert(1)&lt;/script&gt;</p>`, `\<script\>alert(1)\</script\>`},
+		{`<p>2*3*4 and _x_</p>`, `2\*3\*4 and \_x\_`},
+		{`<p>[not](a link) \o/</p>`, `\[not\](a link) \\o/`},
+		{"<p><code>a `b</code></p>", "``a `b``"},
+		{"<p><code>`x`</code></p>", "`` `x` ``"},
+		{`<p><code>*ptr</code></p>`, "`*ptr`"},
+		{`<p>foo<em>bar</em>baz</p>`, "foo*bar*baz"},
+	}
+
+	for _, tt := range tests {
+		got, err := HTMLToMarkdown(tt.html)
+		if err != nil {
+			t.Fatalf("Error converting html: %s", err.Error())
+		}
+
+		if got != tt.want {
+			t.Errorf("%s: expected %q, got %q", tt.html, tt.want, got)
+		}
+	}
+
+	text, err := HTMLToText(`<p>&lt;b&gt; 2*3</p>`)
+	if err != nil {
+		t.Fatalf("Error converting html: %s", err.Error())
+	}
+
+	if text != "<b> 2*3" {
+		t.Errorf("Expected plain text not to be escaped, got %q", text)
+	}
+}
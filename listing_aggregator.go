@@ -0,0 +1,317 @@
+package dev
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const defaultAggregatorPerPage = 100
+
+type NormalizedListing struct {
+	ID           int64           `json:"id"`
+	Slug         string          `json:"slug"`
+	Title        string          `json:"title"`
+	Category     ListingCategory `json:"category"`
+	Tags         []string        `json:"tags"`
+	Location     string          `json:"location,omitempty"`
+	Organization string          `json:"organization,omitempty"`
+	Author       string          `json:"author,omitempty"`
+	Text         string          `json:"text"`
+	FirstSeenAt  time.Time       `json:"first_seen_at"`
+	LastSeenAt   time.Time       `json:"last_seen_at"`
+}
+
+type ListingAggregatorOptions struct {
+	// Categories to page through, defaults to jobs only
+	Categories []ListingCategory
+	// PerPage is the page size used when fetching listings, defaults to 100
+	PerPage int32
+	// MaxPages limits the pages fetched per category, 0 means no limit
+	MaxPages int
+	// StatePath is the file aggregated listings are persisted to between runs.
+	// When empty, listings are kept in memory only
+	StatePath string
+}
+
+// ListingFilter narrows down aggregated listings. Empty fields match everything
+type ListingFilter struct {
+	// Keywords must all appear in the title, text or tags
+	Keywords []string
+	// Tags matches listings having at least one of the tags
+	Tags []string
+	// Location is matched as a substring of the listing location
+	Location   string
+	Categories []ListingCategory
+}
+
+// ListingAggregator collects listings across categories, normalizes
+// and deduplicates them, and keeps them between runs
+type ListingAggregator struct {
+	client *Client
+	opts   ListingAggregatorOptions
+
+	mu       sync.RWMutex
+	listings map[int64]*NormalizedListing
+	slugs    map[string]int64
+}
+
+// NewListingAggregator returns an aggregator for published listings. If a state
+// file exists at opts.StatePath, previously aggregated listings are loaded
+func (c *Client) NewListingAggregator(opts ListingAggregatorOptions) (*ListingAggregator, error) {
+	if len(opts.Categories) == 0 {
+		opts.Categories = []ListingCategory{ListingCategoryJobs}
+	}
+
+	for _, category := range opts.Categories {
+		if err := validateListingCategory(category); err != nil {
+			return nil, err
+		}
+	}
+
+	if opts.PerPage <= 0 {
+		opts.PerPage = defaultAggregatorPerPage
+	}
+
+	a := &ListingAggregator{
+		client:   c,
+		opts:     opts,
+		listings: map[int64]*NormalizedListing{},
+		slugs:    map[string]int64{},
+	}
+
+	if err := a.loadState(); err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// NormalizeListing flattens a listing into the fields used for searching.
+// The processed html is converted to plain text
+func NormalizeListing(l Listing) NormalizedListing {
+	n := NormalizedListing{
+		ID:       l.ID,
+		Slug:     l.Slug,
+		Title:    strings.TrimSpace(l.Title),
+		Category: l.Category,
+		Location: strings.TrimSpace(l.Location),
+	}
+
+	tags := l.Tags
+	if len(tags) == 0 && l.TagList != "" {
+		tags = strings.Split(l.TagList, ",")
+	}
+
+	for _, tag := range tags {
+		if tag = strings.ToLower(strings.TrimSpace(tag)); tag != "" {
+			n.Tags = append(n.Tags, tag)
+		}
+	}
+
+	if l.Organization != nil {
+		n.Organization = l.Organization.Name
+	}
+
+	if l.User != nil {
+		n.Author = l.User.Username
+	}
+
+	text, err := HTMLToText(l.ProcessedHTML)
+	if err != nil || text == "" {
+		text = strings.TrimSpace(l.BodyMarkdown)
+	}
+	n.Text = text
+
+	return n
+}
+
+// Refresh pages through every configured category and merges the results.
+// It returns the listings that were not seen before
+func (a *ListingAggregator) Refresh(ctx context.Context) ([]NormalizedListing, error) {
+	now := time.Now().UTC()
+
+	var added []NormalizedListing
+
+	for _, category := range a.opts.Categories {
+		for page := int32(1); a.opts.MaxPages == 0 || int(page) <= a.opts.MaxPages; page++ {
+			if err := ctx.Err(); err != nil {
+				return added, err
+			}
+
+			listings, err := a.client.GetPublishedListingsByCategory(
+				category,
+				ListingQueryParams{
+					Page:    page,
+					PerPage: a.opts.PerPage,
+				},
+			)
+			if err != nil {
+				return added, err
+			}
+
+			for _, l := range listings {
+				if n, isNew := a.merge(NormalizeListing(l), now); isNew {
+					added = append(added, n)
+				}
+			}
+
+			if len(listings) < int(a.opts.PerPage) {
+				break
+			}
+		}
+	}
+
+	return added, a.saveState()
+}
+
+func (a *ListingAggregator) merge(n NormalizedListing, now time.Time) (NormalizedListing, bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	id := n.ID
+	if existingID, ok := a.slugs[n.Slug]; ok && n.Slug != "" {
+		id = existingID
+	}
+
+	existing, ok := a.listings[id]
+	if ok {
+		n.ID = existing.ID
+		n.FirstSeenAt = existing.FirstSeenAt
+	} else {
+		n.FirstSeenAt = now
+	}
+
+	n.LastSeenAt = now
+
+	a.listings[n.ID] = &n
+	if n.Slug != "" {
+		a.slugs[n.Slug] = n.ID
+	}
+
+	return n, !ok
+}
+
+// Listings returns every aggregated listing, most recently seen first
+func (a *ListingAggregator) Listings() []NormalizedListing {
+	return a.Search(ListingFilter{})
+}
+
+// Search returns the aggregated listings matching the filter,
+// most recently seen first
+func (a *ListingAggregator) Search(f ListingFilter) []NormalizedListing {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	var result []NormalizedListing
+
+	for _, n := range a.listings {
+		if f.Match(*n) {
+			result = append(result, *n)
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if !result[i].LastSeenAt.Equal(result[j].LastSeenAt) {
+			return result[i].LastSeenAt.After(result[j].LastSeenAt)
+		}
+
+		return result[i].ID > result[j].ID
+	})
+
+	return result
+}
+
+// Match reports whether the listing satisfies the filter
+func (f ListingFilter) Match(n NormalizedListing) bool {
+	if len(f.Categories) > 0 {
+		found := false
+		for _, category := range f.Categories {
+			if n.Category == category {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	if f.Location != "" && !strings.Contains(strings.ToLower(n.Location), strings.ToLower(f.Location)) {
+		return false
+	}
+
+	if len(f.Tags) > 0 {
+		found := false
+		for _, want := range f.Tags {
+			for _, tag := range n.Tags {
+				if strings.EqualFold(want, tag) {
+					found = true
+				}
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	haystack := strings.ToLower(n.Title + " " + n.Text + " " + strings.Join(n.Tags, " "))
+	for _, keyword := range f.Keywords {
+		if !strings.Contains(haystack, strings.ToLower(keyword)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (a *ListingAggregator) loadState() error {
+	if a.opts.StatePath == "" {
+		return nil
+	}
+
+	b, err := ioutil.ReadFile(a.opts.StatePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	var listings []NormalizedListing
+
+	if err := json.Unmarshal(b, &listings); err != nil {
+		return err
+	}
+
+	for i := range listings {
+		a.listings[listings[i].ID] = &listings[i]
+
+		if listings[i].Slug != "" {
+			a.slugs[listings[i].Slug] = listings[i].ID
+		}
+	}
+
+	return nil
+}
+
+func (a *ListingAggregator) saveState() error {
+	if a.opts.StatePath == "" {
+		return nil
+	}
+
+	b, err := json.Marshal(a.Listings())
+	if err != nil {
+		return err
+	}
+
+	return writeFileAtomic(a.opts.StatePath, b)
+}
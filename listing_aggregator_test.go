@@ -0,0 +1,80 @@
+package dev
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"path/filepath"
+	"testing"
+)
+
+func TestListingAggregator(t *testing.T) {
+	job := Listing{
+		ID:            1,
+		Slug:          "go-developer-1",
+		Title:         "Go developer ",
+		Category:      ListingCategoryJobs,
+		Tags:          []string{"Go", "remote"},
+		Location:      "Berlin, Germany",
+		ProcessedHTML: "<p>We are hiring a <strong>backend</strong> engineer</p>",
+		Organization:  &Organization{Name: "ACME"},
+	}
+
+	// the same listing re-posted under another id
+	duplicate := job
+	duplicate.ID = 2
+
+	c := newMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var listings []Listing
+
+		switch r.URL.Path {
+		case "/listings/category/jobs":
+			listings = []Listing{job}
+		case "/listings/category/forhire":
+			listings = []Listing{
+				duplicate,
+				{ID: 3, Slug: "rust-dev", Title: "Rust dev for hire", Category: ListingCategoryForhire, TagList: "rust"},
+			}
+		}
+
+		json.NewEncoder(w).Encode(listings)
+	}))
+
+	path := filepath.Join(t.TempDir(), "jobs.json")
+	opts := ListingAggregatorOptions{
+		Categories: []ListingCategory{ListingCategoryJobs, ListingCategoryForhire},
+		StatePath:  path,
+	}
+
+	a, err := c.NewListingAggregator(opts)
+	if err != nil {
+		t.Fatalf("Failed to create aggregator: %s", err.Error())
+	}
+
+	added, err := a.Refresh(context.Background())
+	if err != nil {
+		t.Fatalf("Error refreshing listings: %s", err.Error())
+	}
+
+	if len(added) != 2 {
+		t.Fatalf("Expected 2 unique listings, got %d", len(added))
+	}
+
+	reloaded, err := c.NewListingAggregator(opts)
+	if err != nil {
+		t.Fatalf("Failed to create aggregator: %s", err.Error())
+	}
+
+	result := reloaded.Search(ListingFilter{Keywords: []string{"backend"}, Tags: []string{"go"}, Location: "berlin"})
+	if len(result) != 1 {
+		t.Fatalf("Expected 1 matching listing, got %d", len(result))
+	}
+
+	if result[0].Text != "We are hiring a backend engineer" || result[0].Organization != "ACME" {
+		t.Errorf("Unexpected normalized listing: %+v", result[0])
+	}
+
+	if n := len(reloaded.Search(ListingFilter{Tags: []string{"rust"}})); n != 1 {
+		t.Errorf("Expected 1 listing tagged 'rust', got %d", n)
+	}
+}
@@ -0,0 +1,277 @@
+package dev
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	defaultListingCheckInterval = time.Hour
+	defaultListingRenewBefore   = 24 * time.Hour
+	defaultListingLifetime      = 30 * 24 * time.Hour
+)
+
+type ListingManagerOptions struct {
+	// CheckInterval is the time between two checks in Run, defaults to 1 hour
+	CheckInterval time.Duration
+	// RenewBefore is how long before expiry a listing is renewed, defaults to 24 hours
+	RenewBefore time.Duration
+	// Lifetime is how long a listing stays up after being bumped, defaults to 30 days
+	Lifetime time.Duration
+	// BumpInterval bumps listings that were not bumped for this long even if they
+	// are not about to expire. Zero disables periodic bumps
+	BumpInterval time.Duration
+	// StatePath is the file tracked listings are persisted to between runs.
+	// When empty, the state is kept in memory only
+	StatePath string
+}
+
+type ManagedListing struct {
+	ID           int64     `json:"id"`
+	ExpiresAt    time.Time `json:"expires_at"`
+	LastBumpedAt time.Time `json:"last_bumped_at,omitempty"`
+}
+
+type ListingRenewal struct {
+	Listing   ManagedListing
+	Expired   bool
+	Published bool
+	// Err is set when the listing couldn't be bumped or published again
+	Err error
+}
+
+// ListingManager keeps track of the expiry dates of the client's listings
+// and bumps them before they expire
+type ListingManager struct {
+	client *Client
+	opts   ListingManagerOptions
+
+	mu       sync.Mutex
+	listings map[int64]*ManagedListing
+	errors   chan error
+	now      func() time.Time
+}
+
+// NewListingManager returns a manager for the client's listings. If a state
+// file exists at opts.StatePath, previously tracked listings are loaded
+func (c *Client) NewListingManager(opts ListingManagerOptions) (*ListingManager, error) {
+	if opts.CheckInterval <= 0 {
+		opts.CheckInterval = defaultListingCheckInterval
+	}
+
+	if opts.RenewBefore <= 0 {
+		opts.RenewBefore = defaultListingRenewBefore
+	}
+
+	if opts.Lifetime <= 0 {
+		opts.Lifetime = defaultListingLifetime
+	}
+
+	m := &ListingManager{
+		client:   c,
+		opts:     opts,
+		listings: map[int64]*ManagedListing{},
+		errors:   make(chan error, 16),
+		now:      time.Now,
+	}
+
+	if err := m.loadState(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// Track starts managing the listing. A zero expiresAt assumes the
+// listing was just created or bumped
+func (m *ListingManager) Track(listingID int64, expiresAt time.Time) error {
+	m.mu.Lock()
+	now := m.now()
+	if expiresAt.IsZero() {
+		expiresAt = now.Add(m.opts.Lifetime)
+	}
+
+	m.listings[listingID] = &ManagedListing{
+		ID:           listingID,
+		ExpiresAt:    expiresAt,
+		LastBumpedAt: expiresAt.Add(-m.opts.Lifetime),
+	}
+	m.mu.Unlock()
+
+	return m.saveState()
+}
+
+// Untrack stops managing the listing
+func (m *ListingManager) Untrack(listingID int64) error {
+	m.mu.Lock()
+	delete(m.listings, listingID)
+	m.mu.Unlock()
+
+	return m.saveState()
+}
+
+// Listings returns the tracked listings ordered by expiry date
+func (m *ListingManager) Listings() []ManagedListing {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	listings := make([]ManagedListing, 0, len(m.listings))
+	for _, l := range m.listings {
+		listings = append(listings, *l)
+	}
+
+	sort.Slice(listings, func(i, j int) bool {
+		return listings[i].ExpiresAt.Before(listings[j].ExpiresAt)
+	})
+
+	return listings
+}
+
+// Due returns the tracked listings that need to be bumped at the given time
+func (m *ListingManager) Due(at time.Time) []ManagedListing {
+	var due []ManagedListing
+
+	for _, l := range m.Listings() {
+		expiring := !at.Before(l.ExpiresAt.Add(-m.opts.RenewBefore))
+		stale := m.opts.BumpInterval > 0 && !at.Before(l.LastBumpedAt.Add(m.opts.BumpInterval))
+
+		if expiring || stale {
+			due = append(due, l)
+		}
+	}
+
+	return due
+}
+
+// Errors returns a channel of errors encountered by Run.
+// Errors are dropped if the channel is not drained
+func (m *ListingManager) Errors() <-chan error {
+	return m.errors
+}
+
+// RenewDue bumps every listing that is due. Listings that already expired
+// are published again after being bumped since DEV unpublishes them on expiry.
+// A listing that fails to renew doesn't hold up the others, its error is set
+// on its renewal and the errors are returned together
+func (m *ListingManager) RenewDue(ctx context.Context) ([]ListingRenewal, error) {
+	now := m.now()
+	client := m.client.WithContext(ctx)
+
+	var renewals []ListingRenewal
+	var errs []error
+
+	for _, l := range m.Due(now) {
+		if err := ctx.Err(); err != nil {
+			return renewals, err
+		}
+
+		id := strconv.FormatInt(l.ID, 10)
+
+		renewal := ListingRenewal{Listing: l, Expired: !now.Before(l.ExpiresAt)}
+
+		if _, err := client.BumpListing(id); err != nil {
+			renewal.Err = fmt.Errorf("bumping listing %d: %w", l.ID, err)
+			renewals = append(renewals, renewal)
+			errs = append(errs, renewal.Err)
+
+			continue
+		}
+
+		// the bump went through even if publishing fails, so the new
+		// expiry is recorded either way
+		if renewal.Expired {
+			if _, err := client.PublishListing(id); err != nil {
+				renewal.Err = fmt.Errorf("publishing listing %d: %w", l.ID, err)
+				errs = append(errs, renewal.Err)
+			} else {
+				renewal.Published = true
+			}
+		}
+
+		m.mu.Lock()
+		tracked, ok := m.listings[l.ID]
+		if ok {
+			tracked.LastBumpedAt = now
+			tracked.ExpiresAt = now.Add(m.opts.Lifetime)
+			renewal.Listing = *tracked
+		}
+		m.mu.Unlock()
+
+		if err := m.saveState(); err != nil {
+			return renewals, err
+		}
+
+		renewals = append(renewals, renewal)
+	}
+
+	return renewals, errors.Join(errs...)
+}
+
+// Run renews due listings on every check interval until the
+// context is cancelled
+func (m *ListingManager) Run(ctx context.Context) error {
+	ticker := time.NewTicker(m.opts.CheckInterval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := m.RenewDue(ctx); err != nil && ctx.Err() == nil {
+			select {
+			case m.errors <- err:
+			default:
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (m *ListingManager) loadState() error {
+	if m.opts.StatePath == "" {
+		return nil
+	}
+
+	b, err := ioutil.ReadFile(m.opts.StatePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	var listings []ManagedListing
+
+	if err := json.Unmarshal(b, &listings); err != nil {
+		return err
+	}
+
+	for i := range listings {
+		m.listings[listings[i].ID] = &listings[i]
+	}
+
+	return nil
+}
+
+func (m *ListingManager) saveState() error {
+	if m.opts.StatePath == "" {
+		return nil
+	}
+
+	b, err := json.Marshal(m.Listings())
+	if err != nil {
+		return err
+	}
+
+	return writeFileAtomic(m.opts.StatePath, b)
+}
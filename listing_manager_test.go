@@ -0,0 +1,108 @@
+package dev
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestListingManagerRenewDue(t *testing.T) {
+	var actions []Action
+
+	c := newMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload ListingBodySchema
+		json.NewDecoder(r.Body).Decode(&payload)
+
+		actions = append(actions, payload.Listing.Action)
+
+		json.NewEncoder(w).Encode(Listing{TypeOf: "listing"})
+	}))
+
+	now := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	path := filepath.Join(t.TempDir(), "listings.json")
+
+	m, err := c.NewListingManager(ListingManagerOptions{StatePath: path})
+	if err != nil {
+		t.Fatalf("Failed to create manager: %s", err.Error())
+	}
+	m.now = func() time.Time { return now }
+
+	m.Track(1, now.Add(10*24*time.Hour))
+	m.Track(2, now.Add(2*time.Hour))
+	m.Track(3, now.Add(-time.Hour))
+
+	renewals, err := m.RenewDue(context.Background())
+	if err != nil {
+		t.Fatalf("Error renewing listings: %s", err.Error())
+	}
+
+	if len(renewals) != 2 {
+		t.Fatalf("Expected 2 renewals, got %d", len(renewals))
+	}
+
+	if !renewals[0].Expired || !renewals[0].Published || renewals[0].Listing.ID != 3 {
+		t.Errorf("Expected expired listing 3 to be bumped and published, got %+v", renewals[0])
+	}
+
+	want := []Action{Bump, Publish, Bump}
+	if len(actions) != len(want) || actions[0] != want[0] || actions[1] != want[1] || actions[2] != want[2] {
+		t.Fatalf("Expected actions %v, got %v", want, actions)
+	}
+
+	reloaded, err := c.NewListingManager(ListingManagerOptions{StatePath: path})
+	if err != nil {
+		t.Fatalf("Failed to create manager: %s", err.Error())
+	}
+
+	if len(reloaded.Due(now)) != 0 {
+		t.Errorf("Expected no listings to be due after renewal")
+	}
+}
+
+func TestListingManagerRenewDueContinuesAfterFailure(t *testing.T) {
+	var bumped []string
+
+	c := newMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/listings/1" {
+			w.WriteHeader(http.StatusNotFound)
+			json.NewEncoder(w).Encode(map[string]interface{}{"error": "not found", "status": 404})
+			return
+		}
+
+		bumped = append(bumped, r.URL.Path)
+
+		json.NewEncoder(w).Encode(Listing{TypeOf: "listing"})
+	}))
+
+	now := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	m, err := c.NewListingManager(ListingManagerOptions{})
+	if err != nil {
+		t.Fatalf("Failed to create manager: %s", err.Error())
+	}
+	m.now = func() time.Time { return now }
+
+	// the deleted listing expires first
+	m.Track(1, now.Add(time.Hour))
+	m.Track(2, now.Add(2*time.Hour))
+
+	renewals, err := m.RenewDue(context.Background())
+	if err == nil {
+		t.Fatal("Expected the failed renewal to be reported")
+	}
+
+	if len(renewals) != 2 || renewals[0].Err == nil || renewals[1].Err != nil {
+		t.Fatalf("Unexpected renewals: %+v", renewals)
+	}
+
+	if len(bumped) != 1 || bumped[0] != "/listings/2" {
+		t.Errorf("Expected listing 2 to be bumped, got %v", bumped)
+	}
+
+	if due := m.Due(now); len(due) != 1 || due[0].ID != 1 {
+		t.Errorf("Expected only the failed listing to stay due, got %+v", due)
+	}
+}
@@ -1,7 +1,6 @@
 package dev
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/google/go-querystring/query"
@@ -18,6 +17,7 @@ type Listing struct {
 	Category      ListingCategory `json:"category"`
 	ProcessedHTML string          `json:"processed_html"`
 	Published     bool            `json:"published"`
+	Location      string          `json:"location,omitempty"`
 	User          *User           `json:"user"`
 	Organization  *Organization   `json:"organization"`
 }
@@ -53,6 +53,42 @@ const (
 	ListingCategoryMisc      = ListingCategory("misc")
 )
 
+// AllListingCategories returns every listing category supported by the api
+func AllListingCategories() []ListingCategory {
+	return []ListingCategory{
+		ListingCategoryCfp,
+		ListingCategoryForhire,
+		ListingCategoryCollabs,
+		ListingCategoryEducation,
+		ListingCategoryJobs,
+		ListingCategoryMentors,
+		ListingCategoryProducts,
+		ListingCategoryMentees,
+		ListingCategoryForsale,
+		ListingCategoryEvents,
+		ListingCategoryMisc,
+	}
+}
+
+// Valid reports whether the category is one of the known listing categories
+func (l ListingCategory) Valid() bool {
+	for _, category := range AllListingCategories() {
+		if l == category {
+			return true
+		}
+	}
+
+	return false
+}
+
+func validateListingCategory(category ListingCategory) error {
+	if !category.Valid() {
+		return fmt.Errorf("invalid listing category: '%s'", category)
+	}
+
+	return nil
+}
+
 type Action string
 
 const (
@@ -62,15 +98,21 @@ const (
 )
 
 type ListingQueryParams struct {
-	Page     int32  `url:"page"`
-	PerPage  int32  `url:"per_page"`
-	Category string `url:"category"`
+	Page     int32           `url:"page,omitempty"`
+	PerPage  int32           `url:"per_page,omitempty"`
+	Category ListingCategory `url:"category,omitempty"`
 }
 
 // GetPublishedListings allows the client retrieve a list of listings
 func (c *Client) GetPublishedListings(q ListingQueryParams) ([]Listing, error) {
 	var listings []Listing
 
+	if q.Category != "" {
+		if err := validateListingCategory(q.Category); err != nil {
+			return nil, err
+		}
+	}
+
 	query, err := query.Values(q)
 	if err != nil {
 		return nil, err
@@ -78,7 +120,7 @@ func (c *Client) GetPublishedListings(q ListingQueryParams) ([]Listing, error) {
 
 	path := fmt.Sprintf("/listings?%s", query.Encode())
 
-	req, err := c.NewRequest(context.Background(), "GET", path, nil)
+	req, err := c.NewRequest(c.requestContext("GetPublishedListings"), "GET", path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -104,7 +146,11 @@ func (c *Client) CreateListing(payload ListingBodySchema, filepath interface{})
 		payload.Listing.BodyMarkdown = content
 	}
 
-	req, err := c.NewRequest(context.Background(), "POST", path, payload)
+	if err := payload.Validate(); err != nil {
+		return nil, err
+	}
+
+	req, err := c.NewRequest(c.requestContext("CreateListing"), "POST", path, payload)
 	if err != nil {
 		return nil, err
 	}
@@ -120,9 +166,16 @@ func (c *Client) CreateListing(payload ListingBodySchema, filepath interface{})
 
 // GetPublishedListingsByCategory allows the client to retrieve a list
 // of listings belonging to the given category
-func (c *Client) GetPublishedListingsByCategory(category string, q ListingQueryParams) ([]Listing, error) {
+func (c *Client) GetPublishedListingsByCategory(category ListingCategory, q ListingQueryParams) ([]Listing, error) {
 	var listings []Listing
 
+	if err := validateListingCategory(category); err != nil {
+		return nil, err
+	}
+
+	// the category is part of the path
+	q.Category = ""
+
 	query, err := query.Values(q)
 	if err != nil {
 		return nil, err
@@ -130,7 +183,7 @@ func (c *Client) GetPublishedListingsByCategory(category string, q ListingQueryP
 
 	path := fmt.Sprintf("/listings/category/%s?%s", category, query.Encode())
 
-	req, err := c.NewRequest(context.Background(), "GET", path, nil)
+	req, err := c.NewRequest(c.requestContext("GetPublishedListingsByCategory"), "GET", path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -147,7 +200,7 @@ func (c *Client) GetPublishedListingsByCategory(category string, q ListingQueryP
 func (c *Client) GetListingByID(listingID string) (*Listing, error) {
 	path := fmt.Sprintf("/listings/%s", listingID)
 
-	req, err := c.NewRequest(context.Background(), "GET", path, nil)
+	req, err := c.NewRequest(c.requestContext("GetListingByID"), "GET", path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -161,6 +214,7 @@ func (c *Client) GetListingByID(listingID string) (*Listing, error) {
 	return listing, nil
 }
 
+// UpdateListing allows the client to update an existing listing
 func (c *Client) UpdateListing(listingID string, payload ListingBodySchema, filepath interface{}) (*Listing, error) {
 	path := fmt.Sprintf("/listings/%s", listingID)
 
@@ -173,7 +227,7 @@ func (c *Client) UpdateListing(listingID string, payload ListingBodySchema, file
 		payload.Listing.BodyMarkdown = content
 	}
 
-	req, err := c.NewRequest(context.Background(), "PUT", path, payload)
+	req, err := c.NewRequest(c.requestContext("UpdateListing"), "PUT", path, payload)
 	if err != nil {
 		return nil, err
 	}
@@ -186,3 +240,26 @@ func (c *Client) UpdateListing(listingID string, payload ListingBodySchema, file
 
 	return listing, nil
 }
+
+// BumpListing allows the client to bump a listing back to the top of its
+// category. Bumping a listing costs credits
+func (c *Client) BumpListing(listingID string) (*Listing, error) {
+	return c.operation("BumpListing").listingAction(listingID, Bump)
+}
+
+// PublishListing allows the client to publish a previously unpublished listing
+func (c *Client) PublishListing(listingID string) (*Listing, error) {
+	return c.operation("PublishListing").listingAction(listingID, Publish)
+}
+
+// UnpublishListing allows the client to unpublish a listing
+func (c *Client) UnpublishListing(listingID string) (*Listing, error) {
+	return c.operation("UnpublishListing").listingAction(listingID, Unpublish)
+}
+
+func (c *Client) listingAction(listingID string, action Action) (*Listing, error) {
+	payload := ListingBodySchema{}
+	payload.Listing.Action = action
+
+	return c.UpdateListing(listingID, payload, nil)
+}
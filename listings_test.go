@@ -1,6 +1,8 @@
 package dev
 
 import (
+	"encoding/json"
+	"net/http"
 	"os"
 	"strconv"
 	"testing"
@@ -103,3 +105,41 @@ func TestGetListingByID(t *testing.T) {
 		t.Errorf("Expected result to be a listing with id: '%s', instead got '%d'", listingID, listing.ID)
 	}
 }
+
+func TestListingQueryEncoding(t *testing.T) {
+	c := newMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/listings/category/jobs" {
+			t.Errorf("Expected path '/listings/category/jobs', got '%s'", r.URL.Path)
+		}
+
+		if r.URL.RawQuery != "per_page=5" {
+			t.Errorf("Expected query 'per_page=5', got '%s'", r.URL.RawQuery)
+		}
+
+		json.NewEncoder(w).Encode([]Listing{})
+	}))
+
+	_, err := c.GetPublishedListingsByCategory(ListingCategoryJobs, ListingQueryParams{PerPage: 5})
+	if err != nil {
+		t.Errorf("Error fetching listings: %s", err.Error())
+	}
+
+	_, err = c.GetPublishedListingsByCategory(ListingCategory("garage"), ListingQueryParams{})
+	if err == nil {
+		t.Errorf("Expected unknown category to be rejected")
+	}
+}
+
+func TestAllListingCategories(t *testing.T) {
+	categories := AllListingCategories()
+
+	if len(categories) != 11 {
+		t.Errorf("Expected 11 listing categories, got %d", len(categories))
+	}
+
+	for _, category := range categories {
+		if !category.Valid() {
+			t.Errorf("Expected category '%s' to be valid", category)
+		}
+	}
+}
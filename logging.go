@@ -0,0 +1,197 @@
+package dev
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	redacted      = "[REDACTED]"
+	maxLoggedBody = 4096
+)
+
+// Logger receives the structured logs of the client. *slog.Logger
+// implements it
+type Logger interface {
+	Log(ctx context.Context, level slog.Level, msg string, args ...any)
+}
+
+// rateLimitHeaders are logged when the api returns them
+var rateLimitHeaders = []string{
+	"X-RateLimit-Limit",
+	"X-RateLimit-Remaining",
+	"X-RateLimit-Reset",
+	"Retry-After",
+}
+
+// sensitiveHeaders and sensitiveFields are replaced before headers
+// and bodies are logged
+var (
+	sensitiveHeaders = []string{"api-key", "Authorization", "Cookie", "Set-Cookie"}
+	sensitiveFields  = []string{"api_key", "api-key", "token", "password", "secret"}
+)
+
+type attemptKey struct{}
+
+// WithAttempt records the retry attempt in a context, requests sent with
+// it are logged with the attempt, e.g.
+// c.WithContext(WithAttempt(ctx, 2)).GetArticleComments(id). Requests
+// without it are logged as attempt 1
+func WithAttempt(ctx context.Context, attempt int) context.Context {
+	return context.WithValue(ctx, attemptKey{}, attempt)
+}
+
+func attemptFromContext(ctx context.Context) int {
+	if attempt, ok := ctx.Value(attemptKey{}).(int); ok {
+		return attempt
+	}
+
+	return 1
+}
+
+// logDoer logs each request sent by next. It is the innermost step of the
+// chain so the headers added by middlewares are included
+func (c *Client) logDoer(next Doer) Doer {
+	return DoerFunc(func(r *http.Request) (*http.Response, error) {
+		ctx := r.Context()
+		start := time.Now()
+
+		var reqBody []byte
+		if c.Debug && r.GetBody != nil {
+			if body, err := r.GetBody(); err == nil {
+				reqBody, _ = io.ReadAll(body)
+				body.Close()
+			}
+		}
+
+		resp, err := next.Do(r)
+
+		attrs := []any{
+			slog.String("method", r.Method),
+			slog.String("path", r.URL.Redacted()),
+			slog.Duration("duration", time.Since(start)),
+			slog.Int("attempt", attemptFromContext(ctx)),
+		}
+
+		if err != nil {
+			c.Logger.Log(ctx, slog.LevelError, "dev api request failed", append(attrs, slog.String("error", err.Error()))...)
+			return nil, err
+		}
+
+		attrs = append(attrs, slog.Int("status", resp.StatusCode))
+
+		var limits []any
+		for _, h := range rateLimitHeaders {
+			if v := resp.Header.Get(h); v != "" {
+				limits = append(limits, slog.String(h, v))
+			}
+		}
+
+		if len(limits) > 0 {
+			attrs = append(attrs, slog.Group("rate_limit", limits...))
+		}
+
+		level := slog.LevelInfo
+		if resp.StatusCode >= 400 {
+			level = slog.LevelWarn
+		}
+
+		c.Logger.Log(ctx, level, "dev api request", attrs...)
+
+		if c.Debug {
+			respBody, readErr := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+			if readErr != nil {
+				return nil, readErr
+			}
+
+			c.Logger.Log(ctx, slog.LevelDebug, "dev api request dump",
+				slog.String("method", r.Method),
+				slog.String("path", r.URL.Redacted()),
+				slog.Any("request_headers", redactHeaders(r.Header)),
+				slog.String("request_body", redactBody(reqBody)),
+				slog.Any("response_headers", redactHeaders(resp.Header)),
+				slog.String("response_body", redactBody(respBody)),
+			)
+		}
+
+		return resp, nil
+	})
+}
+
+func redactHeaders(h http.Header) map[string]string {
+	out := make(map[string]string, len(h))
+
+	for k, values := range h {
+		out[k] = strings.Join(values, ", ")
+	}
+
+	for _, k := range sensitiveHeaders {
+		if h.Get(k) != "" {
+			out[http.CanonicalHeaderKey(k)] = redacted
+		}
+	}
+
+	return out
+}
+
+// redactBody replaces the values of sensitive fields in json bodies.
+// Other bodies are logged as they are. Long bodies are truncated
+func redactBody(b []byte) string {
+	if len(b) == 0 {
+		return ""
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(b, &v); err == nil {
+		if redactedJSON, err := json.Marshal(redactValue(v)); err == nil {
+			b = redactedJSON
+		}
+	}
+
+	if len(b) > maxLoggedBody {
+		return string(b[:maxLoggedBody]) + "...(truncated)"
+	}
+
+	return string(b)
+}
+
+func redactValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, value := range v {
+			if isSensitiveField(k) {
+				v[k] = redacted
+				continue
+			}
+
+			v[k] = redactValue(value)
+		}
+	case []interface{}:
+		for i, value := range v {
+			v[i] = redactValue(value)
+		}
+	}
+
+	return v
+}
+
+func isSensitiveField(name string) bool {
+	name = strings.ToLower(name)
+
+	for _, f := range sensitiveFields {
+		if name == f {
+			return true
+		}
+	}
+
+	return false
+}
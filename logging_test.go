@@ -0,0 +1,104 @@
+package dev
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestLogging(t *testing.T) {
+	c := newMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Remaining", "29")
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(map[string]interface{}{"id": 1, "token": "secret-value", "url": "https://dev.to/x"})
+	}))
+
+	var buf bytes.Buffer
+
+	c.Logger = slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	c.Debug = true
+
+	var payload WebhookBodySchema
+	payload.WebhookEndpoint.Source = "DEV"
+	payload.WebhookEndpoint.TargetURL = "https://example.com/hook"
+
+	webhook, err := c.CreateWebhook(payload)
+	if err != nil {
+		t.Fatalf("Error creating webhook: %s", err.Error())
+	}
+
+	// the response is still decoded after the body was logged
+	if webhook.ID != 1 {
+		t.Errorf("Expected webhook id 1, got %d", webhook.ID)
+	}
+
+	if strings.Contains(buf.String(), "test-token") || strings.Contains(buf.String(), "secret-value") {
+		t.Errorf("Expected secrets to be redacted:\n%s", buf.String())
+	}
+
+	var records []map[string]interface{}
+
+	scanner := bufio.NewScanner(&buf)
+	for scanner.Scan() {
+		var record map[string]interface{}
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			t.Fatalf("Invalid log line: %s", scanner.Text())
+		}
+
+		records = append(records, record)
+	}
+
+	if len(records) != 2 {
+		t.Fatalf("Expected 2 log records, got %d", len(records))
+	}
+
+	request := records[0]
+
+	if request["method"] != "POST" || request["path"] != c.BaseUrl.String()+"/webhooks" {
+		t.Errorf("Unexpected request record: %v", request)
+	}
+
+	if request["status"] != float64(201) || request["attempt"] != float64(1) {
+		t.Errorf("Unexpected request record: %v", request)
+	}
+
+	if limits, _ := request["rate_limit"].(map[string]interface{}); limits["X-RateLimit-Remaining"] != "29" {
+		t.Errorf("Expected rate limit headers, got %v", request["rate_limit"])
+	}
+
+	dump := records[1]
+
+	if headers, _ := dump["request_headers"].(map[string]interface{}); headers["Api-Key"] != redacted {
+		t.Errorf("Expected api key header to be redacted, got %v", dump["request_headers"])
+	}
+
+	if !strings.Contains(dump["request_body"].(string), "https://example.com/hook") {
+		t.Errorf("Expected request body to be logged, got %v", dump["request_body"])
+	}
+
+	if !strings.Contains(dump["response_body"].(string), `"token":"[REDACTED]"`) {
+		t.Errorf("Expected response token to be redacted, got %v", dump["response_body"])
+	}
+}
+
+func TestLoggingAttempt(t *testing.T) {
+	c := newMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("{}"))
+	}))
+
+	var buf bytes.Buffer
+	c.Logger = slog.New(slog.NewTextHandler(&buf, nil))
+
+	if _, err := c.WithContext(WithAttempt(context.Background(), 3)).GetAuthenticatedUser(); err != nil {
+		t.Fatalf("Error getting user: %s", err.Error())
+	}
+
+	if !strings.Contains(buf.String(), "attempt=3") {
+		t.Errorf("Expected attempt to be logged, got %s", buf.String())
+	}
+}
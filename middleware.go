@@ -0,0 +1,167 @@
+package dev
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"log"
+	"net/http"
+	"time"
+)
+
+const defaultRequestIDHeader = "X-Request-ID"
+
+// Doer sends an http request. *http.Client implements it
+type Doer interface {
+	Do(r *http.Request) (*http.Response, error)
+}
+
+// DoerFunc adapts a function to the Doer interface
+type DoerFunc func(r *http.Request) (*http.Response, error)
+
+func (f DoerFunc) Do(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+// Middleware wraps the Doer that sends the next step of a request
+type Middleware func(next Doer) Doer
+
+// Use adds middlewares to the chain every request of the client goes
+// through. The first middleware added is the outermost one and sees the
+// request first. Use is not safe to call while requests are in flight
+func (c *Client) Use(middlewares ...Middleware) {
+	c.middlewares = append(c.middlewares, middlewares...)
+}
+
+// doer returns the http client wrapped in the request logger, the
+// middleware chain and the telemetry instrumentation, in that order
+func (c *Client) doer() Doer {
+	var d Doer = c.Client
+
+	if c.Logger != nil {
+		d = c.logDoer(d)
+	}
+
+	for i := len(c.middlewares) - 1; i >= 0; i-- {
+		d = c.middlewares[i](d)
+	}
+
+	if c.telemetry != nil {
+		d = c.telemetry.wrap(d)
+	}
+
+	return d
+}
+
+// LoggingMiddleware logs the method, url, status and duration of each
+// request. Headers are not logged so the api key isn't leaked
+func LoggingMiddleware(logger *log.Logger) Middleware {
+	if logger == nil {
+		logger = log.Default()
+	}
+
+	return func(next Doer) Doer {
+		return DoerFunc(func(r *http.Request) (*http.Response, error) {
+			start := time.Now()
+
+			resp, err := next.Do(r)
+			if err != nil {
+				logger.Printf("%s %s failed after %s: %s", r.Method, r.URL.Redacted(), time.Since(start), err.Error())
+				return nil, err
+			}
+
+			logger.Printf("%s %s %d %s", r.Method, r.URL.Redacted(), resp.StatusCode, time.Since(start))
+
+			return resp, nil
+		})
+	}
+}
+
+// RequestIDMiddleware sets a request id header on requests that don't
+// have one. The header defaults to X-Request-ID and ids default to
+// random hex strings
+func RequestIDMiddleware(header string, newID func() string) Middleware {
+	if header == "" {
+		header = defaultRequestIDHeader
+	}
+
+	if newID == nil {
+		newID = randomRequestID
+	}
+
+	return func(next Doer) Doer {
+		return DoerFunc(func(r *http.Request) (*http.Response, error) {
+			if r.Header.Get(header) == "" {
+				r = r.Clone(r.Context())
+				r.Header.Set(header, newID())
+			}
+
+			return next.Do(r)
+		})
+	}
+}
+
+func randomRequestID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return ""
+	}
+
+	return hex.EncodeToString(b)
+}
+
+// HeaderMiddleware adds the headers to every request, replacing any
+// existing values, e.g. to propagate tracing headers
+func HeaderMiddleware(headers http.Header) Middleware {
+	return func(next Doer) Doer {
+		return DoerFunc(func(r *http.Request) (*http.Response, error) {
+			r = r.Clone(r.Context())
+
+			for k, values := range headers {
+				r.Header.Del(k)
+
+				for _, v := range values {
+					r.Header.Add(k, v)
+				}
+			}
+
+			return next.Do(r)
+		})
+	}
+}
+
+// RequestTiming is reported by TimingMiddleware for every request.
+// StatusCode is zero when Err is set
+type RequestTiming struct {
+	Method     string
+	Path       string
+	StatusCode int
+	Duration   time.Duration
+	Err        error
+}
+
+// TimingMiddleware reports how long each request took, e.g. to
+// record metrics
+func TimingMiddleware(observe func(RequestTiming)) Middleware {
+	return func(next Doer) Doer {
+		return DoerFunc(func(r *http.Request) (*http.Response, error) {
+			start := time.Now()
+
+			resp, err := next.Do(r)
+
+			timing := RequestTiming{
+				Method:   r.Method,
+				Path:     r.URL.Path,
+				Duration: time.Since(start),
+				Err:      err,
+			}
+
+			if resp != nil {
+				timing.StatusCode = resp.StatusCode
+			}
+
+			observe(timing)
+
+			return resp, err
+		})
+	}
+}
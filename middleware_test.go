@@ -0,0 +1,94 @@
+package dev
+
+import (
+	"bytes"
+	"encoding/json"
+	"log"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestMiddlewareChain(t *testing.T) {
+	var received http.Header
+
+	c := newMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r.Header.Clone()
+		json.NewEncoder(w).Encode(User{ID: 1})
+	}))
+
+	var order []string
+
+	trace := func(name string) Middleware {
+		return func(next Doer) Doer {
+			return DoerFunc(func(r *http.Request) (*http.Response, error) {
+				order = append(order, name)
+				return next.Do(r)
+			})
+		}
+	}
+
+	var logs bytes.Buffer
+	var timings []RequestTiming
+
+	c.Use(trace("first"), trace("second"))
+	c.Use(
+		RequestIDMiddleware("", func() string { return "req-1" }),
+		HeaderMiddleware(http.Header{"Traceparent": {"00-abc-def-01"}}),
+		LoggingMiddleware(log.New(&logs, "", 0)),
+		TimingMiddleware(func(timing RequestTiming) { timings = append(timings, timing) }),
+	)
+
+	if _, err := c.GetAuthenticatedUser(); err != nil {
+		t.Fatalf("Error getting user: %s", err.Error())
+	}
+
+	if !equalStrings(order, []string{"first", "second"}) {
+		t.Errorf("Unexpected middleware order: %v", order)
+	}
+
+	if received.Get("X-Request-ID") != "req-1" || received.Get("Traceparent") != "00-abc-def-01" {
+		t.Errorf("Expected injected headers, got %v", received)
+	}
+
+	if received.Get("api-key") != "test-token" {
+		t.Errorf("Expected api key to be sent, got '%s'", received.Get("api-key"))
+	}
+
+	if !strings.Contains(logs.String(), "GET ") || !strings.Contains(logs.String(), "/users/me 200") {
+		t.Errorf("Unexpected log output: %s", logs.String())
+	}
+
+	if strings.Contains(logs.String(), "test-token") {
+		t.Error("Expected api key not to be logged")
+	}
+
+	if len(timings) != 1 || timings[0].Path != "/users/me" || timings[0].StatusCode != 200 {
+		t.Errorf("Unexpected timings: %+v", timings)
+	}
+}
+
+func TestRequestIDMiddlewareKeepsExistingID(t *testing.T) {
+	var id string
+
+	d := RequestIDMiddleware("", nil)(DoerFunc(func(r *http.Request) (*http.Response, error) {
+		id = r.Header.Get("X-Request-ID")
+		return &http.Response{StatusCode: 200}, nil
+	}))
+
+	r, _ := http.NewRequest("GET", "https://dev.to/api/users/me", nil)
+	r.Header.Set("X-Request-ID", "existing")
+
+	d.Do(r)
+
+	if id != "existing" {
+		t.Errorf("Expected existing id to be kept, got '%s'", id)
+	}
+
+	r.Header.Del("X-Request-ID")
+	d.Do(r)
+
+	if len(id) != 32 {
+		t.Errorf("Expected a generated id, got '%s'", id)
+	}
+}
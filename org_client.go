@@ -0,0 +1,99 @@
+package dev
+
+import (
+	"sync"
+)
+
+// OrgClient is a client scoped to a single organization. Articles and
+// listings it creates are published under the organization and its list
+// methods default to the organization
+type OrgClient struct {
+	client   *Client
+	username string
+
+	mu           sync.Mutex
+	organization *Organization
+}
+
+// Org returns a client scoped to the organization with the given username.
+// The organization is looked up on first use
+func (c *Client) Org(orgname string) *OrgClient {
+	return &OrgClient{
+		client:   c,
+		username: orgname,
+	}
+}
+
+// Username returns the username of the organization
+func (o *OrgClient) Username() string {
+	return o.username
+}
+
+// Organization returns the organization the client is scoped to.
+// The result is cached after the first successful lookup
+func (o *OrgClient) Organization() (*Organization, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if o.organization != nil {
+		return o.organization, nil
+	}
+
+	organization, err := o.client.GetOrganization(o.username)
+	if err != nil {
+		return nil, err
+	}
+
+	o.organization = organization
+
+	return organization, nil
+}
+
+// ID returns the id of the organization the client is scoped to
+func (o *OrgClient) ID() (int32, error) {
+	organization, err := o.Organization()
+	if err != nil {
+		return 0, err
+	}
+
+	return organization.ID, nil
+}
+
+// CreateArticle creates an article published under the organization
+func (o *OrgClient) CreateArticle(payload ArticleBodySchema, filepath interface{}) (*ArticleVariant, error) {
+	id, err := o.ID()
+	if err != nil {
+		return nil, err
+	}
+
+	payload.Article.OrganizationID = id
+
+	return o.client.CreateArticle(payload, filepath)
+}
+
+// CreateListing creates a listing posted under the organization
+func (o *OrgClient) CreateListing(payload ListingBodySchema, filepath interface{}) (*Listing, error) {
+	id, err := o.ID()
+	if err != nil {
+		return nil, err
+	}
+
+	payload.Listing.OrganizationID = int64(id)
+
+	return o.client.CreateListing(payload, filepath)
+}
+
+// GetArticles retrieves the articles belonging to the organization
+func (o *OrgClient) GetArticles(q OrganizationQueryParams) ([]Article, error) {
+	return o.client.GetOrganizationArticles(o.username, q)
+}
+
+// GetListings retrieves the listings belonging to the organization
+func (o *OrgClient) GetListings(q OrganizationQueryParams) ([]Listing, error) {
+	return o.client.GetOrganizationListings(o.username, q)
+}
+
+// GetUsers retrieves the members of the organization
+func (o *OrgClient) GetUsers(q OrganizationQueryParams) ([]User, error) {
+	return o.client.GetOrganizationUsers(o.username, q)
+}
@@ -0,0 +1,45 @@
+package dev
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestOrgClientCreateArticle(t *testing.T) {
+	lookups := 0
+
+	c := newMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/organizations/acme":
+			lookups++
+			json.NewEncoder(w).Encode(Organization{ID: 42, Username: "acme"})
+		case r.Method == "POST" && r.URL.Path == "/articles":
+			var payload ArticleBodySchema
+			json.NewDecoder(r.Body).Decode(&payload)
+
+			if payload.Article.OrganizationID != 42 {
+				t.Errorf("Expected organization id to be 42, got %d", payload.Article.OrganizationID)
+			}
+
+			json.NewEncoder(w).Encode(ArticleVariant{Article: Article{Title: payload.Article.Title}})
+		default:
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+
+	org := c.Org("acme")
+
+	for i := 0; i < 2; i++ {
+		payload := ArticleBodySchema{}
+		payload.Article.Title = "Engineering at ACME"
+
+		if _, err := org.CreateArticle(payload, nil); err != nil {
+			t.Fatalf("Error creating article: %s", err.Error())
+		}
+	}
+
+	if lookups != 1 {
+		t.Errorf("Expected organization to be looked up once, got %d", lookups)
+	}
+}
@@ -0,0 +1,223 @@
+package dev
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const reportPerPage = 100
+
+type MemberActivity struct {
+	Username           string `json:"username"`
+	Name               string `json:"name"`
+	Articles           int    `json:"articles"`
+	Reactions          int    `json:"reactions"`
+	Comments           int    `json:"comments"`
+	ReadingTimeMinutes int    `json:"reading_time_minutes"`
+}
+
+// TeamActivityReport aggregates the activity of an organization's
+// members over a date range
+type TeamActivityReport struct {
+	Organization string           `json:"organization"`
+	From         time.Time        `json:"from"`
+	To           time.Time        `json:"to"`
+	Members      []MemberActivity `json:"members"`
+	Totals       MemberActivity   `json:"totals"`
+}
+
+// GetTeamActivityReport builds an activity report for the organization's
+// members covering articles published between from (inclusive) and to (exclusive)
+func (c *Client) GetTeamActivityReport(orgname string, from, to time.Time) (*TeamActivityReport, error) {
+	var members []User
+
+	for page := int32(1); ; page++ {
+		users, err := c.GetOrganizationUsers(
+			orgname,
+			OrganizationQueryParams{
+				Page:    page,
+				PerPage: reportPerPage,
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		members = append(members, users...)
+
+		if len(users) < reportPerPage {
+			break
+		}
+	}
+
+	var articles []Article
+
+	for page := int32(1); ; page++ {
+		result, err := c.GetOrganizationArticles(
+			orgname,
+			OrganizationQueryParams{
+				Page:    page,
+				PerPage: reportPerPage,
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		articles = append(articles, result...)
+
+		// articles are returned newest first, older pages can't be in range
+		if len(result) < reportPerPage || allPublishedBefore(result, from) {
+			break
+		}
+	}
+
+	return BuildTeamActivityReport(orgname, members, articles, from, to), nil
+}
+
+// BuildTeamActivityReport aggregates the given articles per member. Authors
+// who are no longer members are included in the report
+func BuildTeamActivityReport(orgname string, members []User, articles []Article, from, to time.Time) *TeamActivityReport {
+	activity := map[string]*MemberActivity{}
+
+	for _, m := range members {
+		activity[m.Username] = &MemberActivity{Username: m.Username, Name: m.Name}
+	}
+
+	for _, a := range articles {
+		if a.User == nil {
+			continue
+		}
+
+		publishedAt, err := parseUTCDate(a.PublishedAt)
+		if err != nil || publishedAt.Before(from) || !publishedAt.Before(to) {
+			continue
+		}
+
+		m, ok := activity[a.User.Username]
+		if !ok {
+			m = &MemberActivity{Username: a.User.Username, Name: a.User.Name}
+			activity[a.User.Username] = m
+		}
+
+		m.Articles++
+		m.Reactions += int(a.PublicReactionsCount)
+		m.Comments += int(a.CommentsCount)
+		m.ReadingTimeMinutes += int(a.ReadingTimeMinutes)
+	}
+
+	report := &TeamActivityReport{
+		Organization: orgname,
+		From:         from,
+		To:           to,
+		Members:      make([]MemberActivity, 0, len(activity)),
+	}
+
+	for _, m := range activity {
+		report.Members = append(report.Members, *m)
+
+		report.Totals.Articles += m.Articles
+		report.Totals.Reactions += m.Reactions
+		report.Totals.Comments += m.Comments
+		report.Totals.ReadingTimeMinutes += m.ReadingTimeMinutes
+	}
+
+	sort.Slice(report.Members, func(i, j int) bool {
+		a, b := report.Members[i], report.Members[j]
+
+		if a.Articles != b.Articles {
+			return a.Articles > b.Articles
+		}
+
+		if a.Reactions != b.Reactions {
+			return a.Reactions > b.Reactions
+		}
+
+		return a.Username < b.Username
+	})
+
+	return report
+}
+
+// WriteJSON writes the report as indented JSON
+func (r *TeamActivityReport) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(r)
+}
+
+// WriteCSV writes one row per member preceded by a header row
+func (r *TeamActivityReport) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+
+	if err := cw.Write([]string{"username", "name", "articles", "reactions", "comments", "reading_time_minutes"}); err != nil {
+		return err
+	}
+
+	for _, m := range r.Members {
+		row := []string{
+			m.Username,
+			m.Name,
+			strconv.Itoa(m.Articles),
+			strconv.Itoa(m.Reactions),
+			strconv.Itoa(m.Comments),
+			strconv.Itoa(m.ReadingTimeMinutes),
+		}
+
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+
+	return cw.Error()
+}
+
+// WriteMarkdown writes the report as a markdown table with a totals row
+func (r *TeamActivityReport) WriteMarkdown(w io.Writer) error {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "## %s activity (%s to %s)\n\n", r.Organization, r.From.Format("2006-01-02"), r.To.Format("2006-01-02"))
+	b.WriteString("| Member | Articles | Reactions | Comments | Reading time (min) |\n")
+	b.WriteString("| --- | ---: | ---: | ---: | ---: |\n")
+
+	for _, m := range r.Members {
+		name := m.Username
+		if m.Name != "" {
+			name = fmt.Sprintf("%s (@%s)", m.Name, m.Username)
+		}
+
+		fmt.Fprintf(&b, "| %s | %d | %d | %d | %d |\n",
+			escapeMarkdownCell(name), m.Articles, m.Reactions, m.Comments, m.ReadingTimeMinutes)
+	}
+
+	fmt.Fprintf(&b, "| **Total** | %d | %d | %d | %d |\n",
+		r.Totals.Articles, r.Totals.Reactions, r.Totals.Comments, r.Totals.ReadingTimeMinutes)
+
+	_, err := io.WriteString(w, b.String())
+
+	return err
+}
+
+func allPublishedBefore(articles []Article, t time.Time) bool {
+	for _, a := range articles {
+		publishedAt, err := parseUTCDate(a.PublishedAt)
+		if err != nil || !publishedAt.Before(t) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func escapeMarkdownCell(s string) string {
+	return strings.ReplaceAll(s, "|", `\|`)
+}
@@ -0,0 +1,60 @@
+package dev
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestBuildTeamActivityReport(t *testing.T) {
+	alice := &User{Username: "alice", Name: "Alice"}
+	bob := &User{Username: "bob", Name: "Bob"}
+
+	articles := []Article{
+		{User: alice, PublishedAt: "2021-06-20T10:00:00Z", PublicReactionsCount: 10, CommentsCount: 2, ReadingTimeMinutes: 5},
+		{User: alice, PublishedAt: "2021-06-02T10:00:00Z", PublicReactionsCount: 3, CommentsCount: 1, ReadingTimeMinutes: 4},
+		{User: bob, PublishedAt: "2021-05-30T10:00:00Z", PublicReactionsCount: 50},
+		{User: &User{Username: "former"}, PublishedAt: "2021-06-10T10:00:00Z", PublicReactionsCount: 1},
+	}
+
+	from := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	report := BuildTeamActivityReport("acme", []User{*alice, *bob}, articles, from, to)
+
+	if len(report.Members) != 3 {
+		t.Fatalf("Expected 3 members, got %d", len(report.Members))
+	}
+
+	got := report.Members[0]
+	if got.Username != "alice" || got.Articles != 2 || got.Reactions != 13 || got.Comments != 3 || got.ReadingTimeMinutes != 9 {
+		t.Errorf("Unexpected activity for 'alice': %+v", got)
+	}
+
+	if report.Members[2].Username != "bob" || report.Members[2].Articles != 0 {
+		t.Errorf("Expected 'bob' to have no articles in range, got %+v", report.Members[2])
+	}
+
+	if report.Totals.Articles != 3 || report.Totals.Reactions != 14 {
+		t.Errorf("Unexpected totals: %+v", report.Totals)
+	}
+
+	var csvOut, mdOut bytes.Buffer
+
+	if err := report.WriteCSV(&csvOut); err != nil {
+		t.Fatalf("Error writing csv: %s", err.Error())
+	}
+
+	if !strings.HasPrefix(csvOut.String(), "username,name,articles,reactions,comments,reading_time_minutes\nalice,Alice,2,13,3,9\n") {
+		t.Errorf("Unexpected csv output:\n%s", csvOut.String())
+	}
+
+	if err := report.WriteMarkdown(&mdOut); err != nil {
+		t.Fatalf("Error writing markdown: %s", err.Error())
+	}
+
+	if !strings.Contains(mdOut.String(), "| Alice (@alice) | 2 | 13 | 3 | 9 |") {
+		t.Errorf("Unexpected markdown output:\n%s", mdOut.String())
+	}
+}
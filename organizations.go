@@ -1,7 +1,6 @@
 package dev
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/google/go-querystring/query"
@@ -9,6 +8,7 @@ import (
 
 type Organization struct {
 	TypeOf          string `json:"type_of"`
+	ID              int32  `json:"id"`
 	Name            string `json:"name"`
 	Username        string `json:"username"`
 	Summary         string `json:"summary"`
@@ -28,7 +28,7 @@ type Organization struct {
 type OrganizationQueryParams struct {
 	Page     int32           `url:"page,omitempty"`
 	PerPage  int32           `url:"per_page,omitempty"`
-	Category ListingCategory `json:"category,omitempty"`
+	Category ListingCategory `url:"category,omitempty"`
 }
 
 // GetOrganization allows the client retrieve a single organization
@@ -36,7 +36,26 @@ type OrganizationQueryParams struct {
 func (c *Client) GetOrganization(orgname string) (*Organization, error) {
 	path := fmt.Sprintf("/organizations/%s", orgname)
 
-	req, err := c.NewRequest(context.Background(), "GET", path, nil)
+	req, err := c.NewRequest(c.requestContext("GetOrganization"), "GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	organization := new(Organization)
+
+	if err := c.SendHttpRequest(req, &organization); err != nil {
+		return nil, err
+	}
+
+	return organization, nil
+}
+
+// GetOrganizationByID allows the client retrieve a single organization
+// by its id
+func (c *Client) GetOrganizationByID(orgID string) (*Organization, error) {
+	path := fmt.Sprintf("/organizations/%s", orgID)
+
+	req, err := c.NewRequest(c.requestContext("GetOrganizationByID"), "GET", path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -62,7 +81,7 @@ func (c *Client) GetOrganizationUsers(orgname string, q OrganizationQueryParams)
 
 	path := fmt.Sprintf("/organizations/%s/users?%s", orgname, query.Encode())
 
-	req, err := c.NewRequest(context.Background(), "GET", path, nil)
+	req, err := c.NewRequest(c.requestContext("GetOrganizationUsers"), "GET", path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -79,6 +98,12 @@ func (c *Client) GetOrganizationUsers(orgname string, q OrganizationQueryParams)
 func (c *Client) GetOrganizationListings(orgname string, q OrganizationQueryParams) ([]Listing, error) {
 	var listings []Listing
 
+	if q.Category != "" {
+		if err := validateListingCategory(q.Category); err != nil {
+			return nil, err
+		}
+	}
+
 	query, err := query.Values(q)
 	if err != nil {
 		return nil, err
@@ -86,7 +111,7 @@ func (c *Client) GetOrganizationListings(orgname string, q OrganizationQueryPara
 
 	path := fmt.Sprintf("/organizations/%s/listings?%s", orgname, query.Encode())
 
-	req, err := c.NewRequest(context.Background(), "GET", path, nil)
+	req, err := c.NewRequest(c.requestContext("GetOrganizationListings"), "GET", path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -110,7 +135,7 @@ func (c *Client) GetOrganizationArticles(orgname string, q OrganizationQueryPara
 
 	path := fmt.Sprintf("/organizations/%s/articles?%s", orgname, query.Encode())
 
-	req, err := c.NewRequest(context.Background(), "GET", path, nil)
+	req, err := c.NewRequest(c.requestContext("GetOrganizationArticles"), "GET", path, nil)
 	if err != nil {
 		return nil, err
 	}
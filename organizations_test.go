@@ -2,6 +2,7 @@ package dev
 
 import (
 	"os"
+	"strconv"
 	"testing"
 )
 
@@ -104,3 +105,24 @@ func TestGetOrganizationArticles(t *testing.T) {
 		}
 	}
 }
+
+func TestGetOrganizationByID(t *testing.T) {
+	c, err := NewTestClient()
+	if err != nil {
+		t.Errorf("Failed to create TestClient: %s", err.Error())
+	}
+
+	org, err := c.GetOrganization(os.Getenv("TEST_ORGANIZATION_USERNAME"))
+	if err != nil {
+		t.Errorf("Error fetching organization: %s", err.Error())
+	}
+
+	orgByID, err := c.GetOrganizationByID(strconv.Itoa(int(org.ID)))
+	if err != nil {
+		t.Errorf("Error fetching organization: %s", err.Error())
+	}
+
+	if orgByID.Username != org.Username {
+		t.Errorf("Expected organization username to be '%s', instead got '%s'", org.Username, orgByID.Username)
+	}
+}
@@ -1,29 +1,33 @@
 package dev
 
 import (
-	"context"
+	"errors"
 	"fmt"
 
 	"github.com/google/go-querystring/query"
 )
 
-type PodcastEpisode struct {
-	TypeOf   string `json:"type_of"`
-	ID       int32  `json:"id"`
-	Path     string `json:"path"`
-	ImageURL string `json:"image_url"`
+const podcastEpisodesPerPage = 100
+
+type Podcast struct {
 	Title    string `json:"title"`
-	Podcast  struct {
-		Title    string `json:"title"`
-		Slug     string `json:"slug"`
-		ImageURL string `json:"image_url"`
-	}
+	Slug     string `json:"slug"`
+	ImageURL string `json:"image_url"`
+}
+
+type PodcastEpisode struct {
+	TypeOf   string  `json:"type_of"`
+	ID       int32   `json:"id"`
+	Path     string  `json:"path"`
+	ImageURL string  `json:"image_url"`
+	Title    string  `json:"title"`
+	Podcast  Podcast `json:"podcast"`
 }
 
 type PodcastQueryParams struct {
-	Page     int32  `url:"page"`
-	PerPage  int32  `url:"per_page"`
-	Username string `url:"username"`
+	Page     int32  `url:"page,omitempty"`
+	PerPage  int32  `url:"per_page,omitempty"`
+	Username string `url:"username,omitempty"`
 }
 
 // GetPublishedPodcastEpisodes allows the client to retrieve a list of podcast episodes
@@ -37,7 +41,7 @@ func (c *Client) GetPublishedPodcastEpisodes(q PodcastQueryParams) ([]PodcastEpi
 
 	path := fmt.Sprintf("/podcast_episodes?%s", query.Encode())
 
-	req, err := c.NewRequest(context.Background(), "GET", path, nil)
+	req, err := c.NewRequest(c.requestContext("GetPublishedPodcastEpisodes"), "GET", path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -48,3 +52,90 @@ func (c *Client) GetPublishedPodcastEpisodes(q PodcastQueryParams) ([]PodcastEpi
 
 	return podcasts, nil
 }
+
+// GetPodcastEpisodesByPodcast allows the client to retrieve a list of episodes
+// belonging to the podcast with the given slug
+func (c *Client) GetPodcastEpisodesByPodcast(slug string, q PodcastQueryParams) ([]PodcastEpisode, error) {
+	if slug == "" {
+		return nil, errors.New("podcast slug is required")
+	}
+
+	q.Username = slug
+
+	return c.operation("GetPodcastEpisodesByPodcast").GetPublishedPodcastEpisodes(q)
+}
+
+// PodcastEpisodeIterator pages through the episodes of a podcast.
+// Call Next until it returns false, then check Err
+type PodcastEpisodeIterator struct {
+	client  *Client
+	slug    string
+	perPage int32
+	page    int32
+
+	buf     []PodcastEpisode
+	current PodcastEpisode
+	done    bool
+	err     error
+}
+
+// PodcastEpisodes returns an iterator over every episode of the podcast
+// with the given slug
+func (c *Client) PodcastEpisodes(slug string) *PodcastEpisodeIterator {
+	return &PodcastEpisodeIterator{
+		client:  c,
+		slug:    slug,
+		perPage: podcastEpisodesPerPage,
+	}
+}
+
+// Next advances the iterator to the next episode, fetching the next page
+// when needed. It returns false when the episodes are exhausted or an
+// error occurred
+func (it *PodcastEpisodeIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+
+	if len(it.buf) == 0 {
+		if it.done {
+			return false
+		}
+
+		it.page++
+
+		episodes, err := it.client.GetPodcastEpisodesByPodcast(
+			it.slug,
+			PodcastQueryParams{
+				Page:    it.page,
+				PerPage: it.perPage,
+			},
+		)
+		if err != nil {
+			it.err = err
+			return false
+		}
+
+		it.buf = episodes
+		it.done = len(episodes) < int(it.perPage)
+
+		if len(it.buf) == 0 {
+			return false
+		}
+	}
+
+	it.current = it.buf[0]
+	it.buf = it.buf[1:]
+
+	return true
+}
+
+// Episode returns the episode the iterator is positioned on
+func (it *PodcastEpisodeIterator) Episode() PodcastEpisode {
+	return it.current
+}
+
+// Err returns the error that stopped the iteration, if any
+func (it *PodcastEpisodeIterator) Err() error {
+	return it.err
+}
@@ -1,6 +1,8 @@
 package dev
 
 import (
+	"encoding/json"
+	"net/http"
 	"os"
 	"testing"
 )
@@ -36,3 +38,42 @@ func TestGetPublishedPodcastEpisodes(t *testing.T) {
 		}
 	}
 }
+
+func TestPodcastEpisodeIterator(t *testing.T) {
+	c := newMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+
+		if q.Get("username") != "codenewbie" {
+			t.Errorf("Expected 'username' to be 'codenewbie', got '%s'", q.Get("username"))
+		}
+
+		episodes := []PodcastEpisode{}
+		if q.Get("page") == "1" {
+			for i := 0; i < podcastEpisodesPerPage; i++ {
+				episodes = append(episodes, PodcastEpisode{ID: int32(i)})
+			}
+		} else if q.Get("page") == "2" {
+			episodes = append(episodes, PodcastEpisode{ID: podcastEpisodesPerPage})
+		}
+
+		json.NewEncoder(w).Encode(episodes)
+	}))
+
+	it := c.PodcastEpisodes("codenewbie")
+
+	count := 0
+	for it.Next() {
+		if it.Episode().ID != int32(count) {
+			t.Errorf("Expected episode %d, got %d", count, it.Episode().ID)
+		}
+		count++
+	}
+
+	if err := it.Err(); err != nil {
+		t.Fatalf("Error iterating episodes: %s", err.Error())
+	}
+
+	if count != podcastEpisodesPerPage+1 {
+		t.Errorf("Expected %d episodes, got %d", podcastEpisodesPerPage+1, count)
+	}
+}
@@ -1,7 +1,6 @@
 package dev
 
 import (
-	"context"
 	"fmt"
 )
 
@@ -17,7 +16,7 @@ type ProfileImage struct {
 func (c *Client) GetProfileImage(username string) (*ProfileImage, error) {
 	path := fmt.Sprintf("/profile_images/%s", username)
 
-	req, err := c.NewRequest(context.Background(), "GET", path, nil)
+	req, err := c.NewRequest(c.requestContext("GetProfileImage"), "GET", path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,103 @@
+package dev
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/go-querystring/query"
+)
+
+type ReactionCategory string
+
+const (
+	ReactionCategoryLike          = ReactionCategory("like")
+	ReactionCategoryUnicorn       = ReactionCategory("unicorn")
+	ReactionCategoryReadingList   = ReactionCategory("readinglist")
+	ReactionCategoryExplodingHead = ReactionCategory("exploding_head")
+	ReactionCategoryRaisedHands   = ReactionCategory("raised_hands")
+	ReactionCategoryFire          = ReactionCategory("fire")
+)
+
+type ReactableType string
+
+const (
+	ReactableArticle = ReactableType("Article")
+	ReactableComment = ReactableType("Comment")
+	ReactableUser    = ReactableType("User")
+)
+
+type Reaction struct {
+	Result        string           `json:"result"`
+	Category      ReactionCategory `json:"category"`
+	ID            int64            `json:"id"`
+	ReactableID   int64            `json:"reactable_id"`
+	ReactableType ReactableType    `json:"reactable_type"`
+}
+
+type ReactionQueryParams struct {
+	Category      ReactionCategory `url:"category"`
+	ReactableID   int64            `url:"reactable_id"`
+	ReactableType ReactableType    `url:"reactable_type"`
+}
+
+// Validate checks that the reaction category and reactable type
+// are supported by the api
+func (q ReactionQueryParams) Validate() error {
+	switch q.Category {
+	case ReactionCategoryLike, ReactionCategoryUnicorn, ReactionCategoryReadingList,
+		ReactionCategoryExplodingHead, ReactionCategoryRaisedHands, ReactionCategoryFire:
+	default:
+		return fmt.Errorf("invalid reaction category: '%s'", q.Category)
+	}
+
+	switch q.ReactableType {
+	case ReactableArticle, ReactableComment, ReactableUser:
+	default:
+		return fmt.Errorf("invalid reactable type: '%s'", q.ReactableType)
+	}
+
+	if q.ReactableID == 0 {
+		return errors.New("reactable id is required")
+	}
+
+	return nil
+}
+
+// CreateReaction allows the client to react to an article, comment or user.
+// Creating a reaction that already exists leaves it in place
+func (c *Client) CreateReaction(q ReactionQueryParams) (*Reaction, error) {
+	return c.operation("CreateReaction").sendReaction("/reactions", q)
+}
+
+// ToggleReaction allows the client to add a reaction to an article, comment
+// or user, or to remove it if it already exists. The Result field of the
+// returned reaction is either 'create' or 'destroy'
+func (c *Client) ToggleReaction(q ReactionQueryParams) (*Reaction, error) {
+	return c.operation("ToggleReaction").sendReaction("/reactions/toggle", q)
+}
+
+func (c *Client) sendReaction(path string, q ReactionQueryParams) (*Reaction, error) {
+	if err := q.Validate(); err != nil {
+		return nil, err
+	}
+
+	query, err := query.Values(q)
+	if err != nil {
+		return nil, err
+	}
+
+	path = fmt.Sprintf("%s?%s", path, query.Encode())
+
+	req, err := c.NewRequest(c.Context(), "POST", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	reaction := new(Reaction)
+
+	if err := c.SendHttpRequest(req, &reaction); err != nil {
+		return nil, err
+	}
+
+	return reaction, nil
+}
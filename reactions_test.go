@@ -0,0 +1,81 @@
+package dev
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestToggleReaction(t *testing.T) {
+	c := newMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/reactions/toggle" {
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+
+		q := r.URL.Query()
+
+		json.NewEncoder(w).Encode(Reaction{
+			Result:        "create",
+			Category:      ReactionCategory(q.Get("category")),
+			ReactableType: ReactableType(q.Get("reactable_type")),
+			ReactableID:   1,
+		})
+	}))
+
+	reaction, err := c.ToggleReaction(
+		ReactionQueryParams{
+			Category:      ReactionCategoryUnicorn,
+			ReactableID:   1,
+			ReactableType: ReactableArticle,
+		},
+	)
+
+	if err != nil {
+		t.Fatalf("Error toggling reaction: %s", err.Error())
+	}
+
+	if reaction.Category != ReactionCategoryUnicorn || reaction.ReactableType != ReactableArticle {
+		t.Errorf("Unexpected reaction: %+v", reaction)
+	}
+}
+
+func TestCreateReaction(t *testing.T) {
+	t.Skip()
+	c, err := NewTestClient()
+	if err != nil {
+		t.Errorf("Failed to create TestClient: %s", err.Error())
+	}
+
+	reaction, err := c.CreateReaction(
+		ReactionQueryParams{
+			Category:      ReactionCategoryLike,
+			ReactableID:   721780,
+			ReactableType: ReactableArticle,
+		},
+	)
+
+	if err != nil {
+		t.Errorf("Error creating reaction: %s", err.Error())
+	}
+
+	if reaction.Category != ReactionCategoryLike {
+		t.Errorf("Expected reaction category to be 'like', got '%s'", reaction.Category)
+	}
+}
+
+func TestReactionQueryParamsValidate(t *testing.T) {
+	q := ReactionQueryParams{
+		Category:      ReactionCategory("thumbsup"),
+		ReactableID:   1,
+		ReactableType: ReactableComment,
+	}
+
+	if err := q.Validate(); err == nil {
+		t.Errorf("Expected unknown category to be invalid")
+	}
+
+	q.Category = ReactionCategoryFire
+	if err := q.Validate(); err != nil {
+		t.Errorf("Expected query to be valid, got: %s", err.Error())
+	}
+}
@@ -0,0 +1,175 @@
+package dev
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultTagCatalogTTL = 24 * time.Hour
+	tagCatalogPerPage    = 1000
+)
+
+type TagCatalogOptions struct {
+	// Path is the file the catalog is cached in between runs.
+	// When empty, the catalog is kept in memory only
+	Path string
+	// TTL is how long a cached catalog is considered fresh, defaults to 24 hours
+	TTL time.Duration
+	// MaxPages limits the number of pages fetched on refresh, 0 means no limit
+	MaxPages int
+}
+
+// TagCatalog is a local cache of the tags available on the instance.
+// It is used to validate article tags before sending them to the api
+type TagCatalog struct {
+	client *Client
+	opts   TagCatalogOptions
+
+	mu        sync.RWMutex
+	tags      map[string]Tag
+	fetchedAt time.Time
+}
+
+type tagCatalogFile struct {
+	FetchedAt time.Time `json:"fetched_at"`
+	Tags      []Tag     `json:"tags"`
+}
+
+// NewTagCatalog returns an empty tag catalog, call Load to populate it
+func (c *Client) NewTagCatalog(opts TagCatalogOptions) *TagCatalog {
+	if opts.TTL <= 0 {
+		opts.TTL = defaultTagCatalogTTL
+	}
+
+	return &TagCatalog{
+		client: c,
+		opts:   opts,
+		tags:   map[string]Tag{},
+	}
+}
+
+// Load populates the catalog from the cache file if it is still fresh,
+// otherwise the catalog is fetched from the api and the cache is rewritten
+func (t *TagCatalog) Load() error {
+	if t.opts.Path != "" {
+		b, err := ioutil.ReadFile(t.opts.Path)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+
+		if err == nil {
+			var cached tagCatalogFile
+
+			if err := json.Unmarshal(b, &cached); err != nil {
+				return err
+			}
+
+			if time.Since(cached.FetchedAt) < t.opts.TTL {
+				t.set(cached.Tags, cached.FetchedAt)
+				return nil
+			}
+		}
+	}
+
+	return t.Refresh()
+}
+
+// Refresh fetches every page of the tag catalog from the api
+func (t *TagCatalog) Refresh() error {
+	var tags []Tag
+
+	for page := int32(1); t.opts.MaxPages == 0 || int(page) <= t.opts.MaxPages; page++ {
+		result, err := t.client.GetTags(
+			TagQueryParams{
+				Page:    page,
+				PerPage: tagCatalogPerPage,
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		tags = append(tags, result...)
+
+		if len(result) < tagCatalogPerPage {
+			break
+		}
+	}
+
+	fetchedAt := time.Now().UTC()
+	t.set(tags, fetchedAt)
+
+	if t.opts.Path == "" {
+		return nil
+	}
+
+	b, err := json.Marshal(tagCatalogFile{FetchedAt: fetchedAt, Tags: tags})
+	if err != nil {
+		return err
+	}
+
+	return writeFileAtomic(t.opts.Path, b)
+}
+
+func (t *TagCatalog) set(tags []Tag, fetchedAt time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.tags = make(map[string]Tag, len(tags))
+	for _, tag := range tags {
+		t.tags[strings.ToLower(tag.Name)] = tag
+	}
+
+	t.fetchedAt = fetchedAt
+}
+
+// Get returns the tag with the given name
+func (t *TagCatalog) Get(name string) (Tag, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	tag, ok := t.tags[strings.ToLower(name)]
+
+	return tag, ok
+}
+
+// Len returns the number of tags in the catalog
+func (t *TagCatalog) Len() int {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return len(t.tags)
+}
+
+// FetchedAt returns the time the catalog was last fetched from the api
+func (t *TagCatalog) FetchedAt() time.Time {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return t.fetchedAt
+}
+
+// ValidateTags returns an error listing the tags that are
+// not part of the catalog
+func (t *TagCatalog) ValidateTags(tags []string) error {
+	var unknown []string
+
+	for _, name := range tags {
+		if _, ok := t.Get(name); !ok {
+			unknown = append(unknown, name)
+		}
+	}
+
+	if len(unknown) > 0 {
+		return fmt.Errorf("unknown tags: %s", strings.Join(unknown, ", "))
+	}
+
+	return nil
+}
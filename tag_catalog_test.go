@@ -0,0 +1,50 @@
+package dev
+
+import (
+	"encoding/json"
+	"net/http"
+	"path/filepath"
+	"testing"
+)
+
+func TestTagCatalog(t *testing.T) {
+	requests := 0
+
+	c := newMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		json.NewEncoder(w).Encode([]Tag{
+			{ID: 1, Name: "go", BGColorHEX: "#000000"},
+			{ID: 2, Name: "rust"},
+		})
+	}))
+
+	path := filepath.Join(t.TempDir(), "tags.json")
+
+	catalog := c.NewTagCatalog(TagCatalogOptions{Path: path})
+	if err := catalog.Load(); err != nil {
+		t.Fatalf("Error loading catalog: %s", err.Error())
+	}
+
+	if tag, ok := catalog.Get("Go"); !ok || tag.BGColorHEX != "#000000" {
+		t.Errorf("Expected catalog to contain 'go', got %+v", tag)
+	}
+
+	if err := catalog.ValidateTags([]string{"go", "cobol"}); err == nil {
+		t.Errorf("Expected unknown tag 'cobol' to fail validation")
+	}
+
+	// a fresh cache file must be used instead of the api
+	cached := c.NewTagCatalog(TagCatalogOptions{Path: path})
+	if err := cached.Load(); err != nil {
+		t.Fatalf("Error loading catalog: %s", err.Error())
+	}
+
+	if requests != 1 {
+		t.Errorf("Expected 1 api request, got %d", requests)
+	}
+
+	if cached.Len() != 2 {
+		t.Errorf("Expected cached catalog to contain 2 tags, got %d", cached.Len())
+	}
+}
@@ -1,13 +1,49 @@
 package dev
 
 import (
-	"context"
+	"fmt"
+
+	"github.com/google/go-querystring/query"
 )
 
 type Tag struct {
-	ID     int64   `json:"id"`
-	Name   string  `json:"name"`
-	Points float64 `json:"points"`
+	ID            int64   `json:"id,omitempty"`
+	Name          string  `json:"name"`
+	Points        float64 `json:"points,omitempty"`
+	BGColorHEX    string  `json:"bg_color_hex,omitempty"`
+	TextColorHEX  string  `json:"text_color_hex,omitempty"`
+	ShortSummary  string  `json:"short_summary,omitempty"`
+	RulesMarkdown string  `json:"rules_markdown,omitempty"`
+	RulesHTML     string  `json:"rules_html,omitempty"`
+}
+
+type TagQueryParams struct {
+	Page    int32 `url:"page,omitempty"`
+	PerPage int32 `url:"per_page,omitempty"`
+}
+
+// GetTags allows the client to retrieve a list of tags
+// that can be used to tag articles
+func (c *Client) GetTags(q TagQueryParams) ([]Tag, error) {
+	var tags []Tag
+
+	query, err := query.Values(q)
+	if err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("/tags?%s", query.Encode())
+
+	req, err := c.NewRequest(c.requestContext("GetTags"), "GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.SendHttpRequest(req, &tags); err != nil {
+		return nil, err
+	}
+
+	return tags, nil
 }
 
 // GetFollowedTags allows the client to retrieve a list of the tags they follow
@@ -16,7 +52,7 @@ func (c *Client) GetFollowedTags() ([]Tag, error) {
 
 	path := "/follows/tags"
 
-	req, err := c.NewRequest(context.Background(), "GET", path, nil)
+	req, err := c.NewRequest(c.requestContext("GetFollowedTags"), "GET", path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -20,3 +20,25 @@ func TestGetFollowedTags(t *testing.T) {
 		t.Errorf("Expected result to be a list of tags, instead got: '%d'", len(tags))
 	}
 }
+
+func TestGetTags(t *testing.T) {
+	c, err := NewTestClient()
+	if err != nil {
+		t.Errorf("Failed to create TestClient: %s", err.Error())
+	}
+
+	tags, err := c.GetTags(
+		TagQueryParams{
+			Page:    1,
+			PerPage: 5,
+		},
+	)
+
+	if err != nil {
+		t.Errorf("Error fetching tags: %s", err.Error())
+	}
+
+	if len(tags) != 5 {
+		t.Errorf("Expected result to contain 5 tags, instead got: '%d'", len(tags))
+	}
+}
@@ -0,0 +1,170 @@
+package dev
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/Mayowa-Ojo/dev-client-go"
+
+// TelemetryOptions configures the OpenTelemetry instrumentation of the
+// client. Unset providers default to the global ones
+type TelemetryOptions struct {
+	TracerProvider trace.TracerProvider
+	MeterProvider  metric.MeterProvider
+	Propagator     propagation.TextMapPropagator
+}
+
+type telemetry struct {
+	tracer      trace.Tracer
+	propagator  propagation.TextMapPropagator
+	requests    metric.Int64Counter
+	duration    metric.Float64Histogram
+	rateLimited metric.Int64Counter
+}
+
+// EnableTelemetry records a span and metrics for every api call. Spans are
+// named after the client method, e.g. dev.GetPublishedArticles, and are
+// children of the span in the client's context, see WithContext. The
+// instrumentation wraps the middleware chain so it measures the whole call
+func (c *Client) EnableTelemetry(opts TelemetryOptions) error {
+	if opts.TracerProvider == nil {
+		opts.TracerProvider = otel.GetTracerProvider()
+	}
+
+	if opts.MeterProvider == nil {
+		opts.MeterProvider = otel.GetMeterProvider()
+	}
+
+	if opts.Propagator == nil {
+		opts.Propagator = otel.GetTextMapPropagator()
+	}
+
+	meter := opts.MeterProvider.Meter(instrumentationName)
+
+	requests, err := meter.Int64Counter("dev.client.requests",
+		metric.WithDescription("Number of api requests"))
+	if err != nil {
+		return err
+	}
+
+	duration, err := meter.Float64Histogram("dev.client.request.duration",
+		metric.WithDescription("Duration of api requests"),
+		metric.WithUnit("s"))
+	if err != nil {
+		return err
+	}
+
+	rateLimited, err := meter.Int64Counter("dev.client.rate_limited",
+		metric.WithDescription("Number of api requests rejected by the rate limit"))
+	if err != nil {
+		return err
+	}
+
+	c.telemetry = &telemetry{
+		tracer:      opts.TracerProvider.Tracer(instrumentationName),
+		propagator:  opts.Propagator,
+		requests:    requests,
+		duration:    duration,
+		rateLimited: rateLimited,
+	}
+
+	return nil
+}
+
+func (t *telemetry) wrap(next Doer) Doer {
+	return DoerFunc(func(r *http.Request) (*http.Response, error) {
+		operation := "dev." + operationFromContext(r.Context())
+
+		attrs := []attribute.KeyValue{
+			attribute.String("dev.operation", operation),
+			attribute.String("http.request.method", r.Method),
+		}
+
+		spanAttrs := []attribute.KeyValue{attribute.String("url.path", r.URL.Path)}
+
+		query := r.URL.Query()
+		for _, param := range []string{"page", "per_page"} {
+			if v, err := strconv.Atoi(query.Get(param)); err == nil {
+				spanAttrs = append(spanAttrs, attribute.Int("dev."+param, v))
+			}
+		}
+
+		ctx, span := t.tracer.Start(r.Context(), operation,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(append(spanAttrs, attrs...)...),
+		)
+		defer span.End()
+
+		r = r.Clone(ctx)
+		t.propagator.Inject(ctx, propagation.HeaderCarrier(r.Header))
+
+		start := time.Now()
+
+		resp, err := next.Do(r)
+
+		elapsed := time.Since(start).Seconds()
+
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			attrs = append(attrs, attribute.String("error.type", "transport"))
+		} else {
+			attrs = append(attrs, attribute.Int("http.response.status_code", resp.StatusCode))
+			span.SetAttributes(attribute.Int("http.response.status_code", resp.StatusCode))
+
+			if resp.StatusCode >= 400 {
+				span.SetStatus(codes.Error, http.StatusText(resp.StatusCode))
+				attrs = append(attrs, attribute.String("error.type", strconv.Itoa(resp.StatusCode)))
+			}
+
+			if resp.StatusCode == http.StatusTooManyRequests {
+				t.rateLimited.Add(ctx, 1, metric.WithAttributes(attrs[0]))
+			}
+		}
+
+		set := metric.WithAttributes(attrs...)
+		t.requests.Add(ctx, 1, set)
+		t.duration.Record(ctx, elapsed, set)
+
+		return resp, err
+	})
+}
+
+type operationKey struct{}
+
+// requestContext returns the context of a request sent by the given client
+// method. Methods that call other methods to send their requests set the
+// operation first, so it isn't replaced here
+func (c *Client) requestContext(operation string) context.Context {
+	ctx := c.Context()
+
+	if _, ok := ctx.Value(operationKey{}).(string); ok {
+		return ctx
+	}
+
+	return context.WithValue(ctx, operationKey{}, operation)
+}
+
+// operation returns a copy of the client whose requests are reported as
+// the given client method
+func (c *Client) operation(name string) *Client {
+	return c.WithContext(c.requestContext(name))
+}
+
+func operationFromContext(ctx context.Context) string {
+	if operation, ok := ctx.Value(operationKey{}).(string); ok {
+		return operation
+	}
+
+	return "SendHttpRequest"
+}
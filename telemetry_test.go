@@ -0,0 +1,163 @@
+package dev
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestTelemetry(t *testing.T) {
+	var traceparent string
+
+	c := newMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparent = r.Header.Get("Traceparent")
+
+		if r.URL.Path == "/listings/1" {
+			w.WriteHeader(http.StatusTooManyRequests)
+			json.NewEncoder(w).Encode(map[string]interface{}{"error": "rate limit reached", "status": 429})
+			return
+		}
+
+		json.NewEncoder(w).Encode([]Article{{ID: 1}})
+	}))
+
+	exporter := tracetest.NewInMemoryExporter()
+	tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	reader := sdkmetric.NewManualReader()
+	meterProvider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+
+	err := c.EnableTelemetry(TelemetryOptions{
+		TracerProvider: tracerProvider,
+		MeterProvider:  meterProvider,
+		Propagator:     propagation.TraceContext{},
+	})
+	if err != nil {
+		t.Fatalf("Error enabling telemetry: %s", err.Error())
+	}
+
+	if _, err := c.GetPublishedArticles(ArticleQueryParams{Page: 2, PerPage: 5}); err != nil {
+		t.Fatalf("Error getting articles: %s", err.Error())
+	}
+
+	if _, err := c.GetListingByID("1"); err == nil {
+		t.Fatal("Expected a rate limit error")
+	}
+
+	spans := exporter.GetSpans()
+	if len(spans) != 2 {
+		t.Fatalf("Expected 2 spans, got %d", len(spans))
+	}
+
+	if spans[0].Name != "dev.GetPublishedArticles" || spans[1].Name != "dev.GetListingByID" {
+		t.Errorf("Unexpected span names: '%s', '%s'", spans[0].Name, spans[1].Name)
+	}
+
+	attrs := attribute.NewSet(spans[0].Attributes...)
+
+	for key, expected := range map[attribute.Key]int64{"dev.page": 2, "dev.per_page": 5, "http.response.status_code": 200} {
+		if v, ok := attrs.Value(key); !ok || v.AsInt64() != expected {
+			t.Errorf("Expected %s to be %d, got %v", key, expected, v.Emit())
+		}
+	}
+
+	if spans[1].Status.Code != codes.Error {
+		t.Errorf("Expected the rate limited span to have an error status, got %v", spans[1].Status)
+	}
+
+	if traceparent == "" {
+		t.Error("Expected the trace context to be propagated")
+	}
+
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(context.Background(), &rm); err != nil {
+		t.Fatalf("Error collecting metrics: %s", err.Error())
+	}
+
+	totals := map[string]int64{}
+
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			switch data := m.Data.(type) {
+			case metricdata.Sum[int64]:
+				for _, dp := range data.DataPoints {
+					totals[m.Name] += dp.Value
+				}
+			case metricdata.Histogram[float64]:
+				for _, dp := range data.DataPoints {
+					totals[m.Name] += int64(dp.Count)
+				}
+			}
+		}
+	}
+
+	expected := map[string]int64{
+		"dev.client.requests":         2,
+		"dev.client.request.duration": 2,
+		"dev.client.rate_limited":     1,
+	}
+
+	for name, count := range expected {
+		if totals[name] != count {
+			t.Errorf("Expected %s to be %d, got %d", name, count, totals[name])
+		}
+	}
+}
+
+func TestTelemetryOperations(t *testing.T) {
+	c := newMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/comments" {
+			json.NewEncoder(w).Encode([]Comment{})
+			return
+		}
+
+		json.NewEncoder(w).Encode(Listing{ID: 1})
+	}))
+
+	exporter := tracetest.NewInMemoryExporter()
+	tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+
+	if err := c.EnableTelemetry(TelemetryOptions{TracerProvider: tracerProvider}); err != nil {
+		t.Fatalf("Error enabling telemetry: %s", err.Error())
+	}
+
+	ctx, parent := tracerProvider.Tracer("test").Start(context.Background(), "parent")
+
+	calls := []func(c *Client) error{
+		func(c *Client) error { _, err := c.GetArticleComments(1); return err },
+		func(c *Client) error { _, err := c.BumpListing("1"); return err },
+		func(c *Client) error { _, err := c.UnpublishListing("1"); return err },
+		func(c *Client) error { _, err := c.UpdateListing("1", ListingBodySchema{}, nil); return err },
+	}
+
+	for _, call := range calls {
+		if err := call(c.WithContext(ctx)); err != nil {
+			t.Fatalf("Error sending request: %s", err.Error())
+		}
+	}
+
+	parent.End()
+
+	spans := exporter.GetSpans()
+	if len(spans) != len(calls)+1 {
+		t.Fatalf("Expected %d spans, got %d", len(calls)+1, len(spans))
+	}
+
+	for i, name := range []string{"dev.GetArticleComments", "dev.BumpListing", "dev.UnpublishListing", "dev.UpdateListing"} {
+		if spans[i].Name != name {
+			t.Errorf("Expected span '%s', got '%s'", name, spans[i].Name)
+		}
+
+		if spans[i].Parent.SpanID() != parent.SpanContext().SpanID() {
+			t.Errorf("Expected span '%s' to be a child of the caller's span", spans[i].Name)
+		}
+	}
+}
@@ -0,0 +1,255 @@
+package dev
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/joho/godotenv"
+)
+
+const defaultTokenVariable = "DEV_API_KEY"
+
+// TokenProvider supplies the api key for each request. Providers are
+// called on every request so they should cache the token and only do
+// expensive work when it may have changed
+type TokenProvider interface {
+	Token(ctx context.Context) (string, error)
+}
+
+// StaticToken is a fixed api key
+type StaticToken string
+
+func (s StaticToken) Token(ctx context.Context) (string, error) {
+	if s == "" {
+		return "", errors.New("invalid token")
+	}
+
+	return string(s), nil
+}
+
+// EnvTokenProvider reads the api key from an environment variable
+type EnvTokenProvider struct {
+	// Name of the variable, defaults to DEV_API_KEY
+	Name string
+}
+
+func (e *EnvTokenProvider) Token(ctx context.Context) (string, error) {
+	name := e.Name
+	if name == "" {
+		name = defaultTokenVariable
+	}
+
+	token := os.Getenv(name)
+	if token == "" {
+		return "", fmt.Errorf("environment variable %s is not set", name)
+	}
+
+	return token, nil
+}
+
+// DotenvTokenProvider reads the api key from a dotenv file. The file is
+// read again whenever it changes
+type DotenvTokenProvider struct {
+	// Path of the file, defaults to .env
+	Path string
+	// Name of the variable, defaults to DEV_API_KEY
+	Name string
+
+	cache fileTokenCache
+}
+
+func (d *DotenvTokenProvider) Token(ctx context.Context) (string, error) {
+	path := d.Path
+	if path == "" {
+		path = ".env"
+	}
+
+	name := d.Name
+	if name == "" {
+		name = defaultTokenVariable
+	}
+
+	return d.cache.load(path, func(b []byte) (string, error) {
+		env, err := godotenv.Unmarshal(string(b))
+		if err != nil {
+			return "", err
+		}
+
+		if env[name] == "" {
+			return "", fmt.Errorf("%s is not set in %s", name, path)
+		}
+
+		return env[name], nil
+	})
+}
+
+// ConfigFileTokenProvider reads the api key from a field of a json config
+// file. The file is read again whenever it changes
+type ConfigFileTokenProvider struct {
+	Path string
+	// Field holding the token, nested fields are separated by dots,
+	// e.g. profiles.work.token. Defaults to token
+	Field string
+
+	cache fileTokenCache
+}
+
+func (f *ConfigFileTokenProvider) Token(ctx context.Context) (string, error) {
+	field := f.Field
+	if field == "" {
+		field = "token"
+	}
+
+	return f.cache.load(f.Path, func(b []byte) (string, error) {
+		var v interface{}
+		if err := json.Unmarshal(b, &v); err != nil {
+			return "", err
+		}
+
+		for _, key := range strings.Split(field, ".") {
+			obj, ok := v.(map[string]interface{})
+			if !ok {
+				v = nil
+				break
+			}
+
+			v = obj[key]
+		}
+
+		token, ok := v.(string)
+		if !ok || token == "" {
+			return "", fmt.Errorf("%s is not set in %s", field, f.Path)
+		}
+
+		return token, nil
+	})
+}
+
+// FileTokenProvider reads the api key from a file holding only the key,
+// such as a mounted secret. The file is checked on every request and read
+// again when it changes, so a rotated key is used without a restart
+type FileTokenProvider struct {
+	Path string
+
+	cache fileTokenCache
+}
+
+func (f *FileTokenProvider) Token(ctx context.Context) (string, error) {
+	return f.cache.load(f.Path, func(b []byte) (string, error) {
+		token := strings.TrimSpace(string(b))
+		if token == "" {
+			return "", fmt.Errorf("%s is empty", f.Path)
+		}
+
+		return token, nil
+	})
+}
+
+// CommandTokenProvider runs a helper command and uses its output as the
+// api key, like git credential helpers. The output is either the key on
+// its own or key=value lines with a token or password key
+type CommandTokenProvider struct {
+	Command string
+	Args    []string
+	// TTL is how long the output is reused before the command is run
+	// again. When zero the command runs once
+	TTL time.Duration
+
+	mu        sync.Mutex
+	token     string
+	fetchedAt time.Time
+}
+
+func (c *CommandTokenProvider) Token(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.token != "" && (c.TTL == 0 || time.Since(c.fetchedAt) < c.TTL) {
+		return c.token, nil
+	}
+
+	var stdout, stderr bytes.Buffer
+
+	cmd := exec.CommandContext(ctx, c.Command, c.Args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("token command failed: %w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	token := parseCommandToken(stdout.String())
+	if token == "" {
+		return "", errors.New("token command returned no token")
+	}
+
+	c.token = token
+	c.fetchedAt = time.Now()
+
+	return token, nil
+}
+
+func parseCommandToken(output string) string {
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+
+	for _, line := range lines {
+		parts := strings.SplitN(strings.TrimSpace(line), "=", 2)
+		if len(parts) == 2 && (parts[0] == "token" || parts[0] == "password") {
+			return strings.TrimSpace(parts[1])
+		}
+	}
+
+	if len(lines) == 1 {
+		return strings.TrimSpace(lines[0])
+	}
+
+	return ""
+}
+
+// fileTokenCache keeps the token parsed from a file until the file's
+// modification time or size changes
+type fileTokenCache struct {
+	mu      sync.Mutex
+	modTime time.Time
+	size    int64
+	token   string
+}
+
+func (f *fileTokenCache) load(path string, parse func([]byte) (string, error)) (string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.token != "" && info.ModTime().Equal(f.modTime) && info.Size() == f.size {
+		return f.token, nil
+	}
+
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	token, err := parse(b)
+	if err != nil {
+		return "", err
+	}
+
+	f.token = token
+	f.modTime = info.ModTime()
+	f.size = info.Size()
+
+	return token, nil
+}
@@ -0,0 +1,98 @@
+package dev
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestTokenProviders(t *testing.T) {
+	dir := t.TempDir()
+	ctx := context.Background()
+
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+
+		return path
+	}
+
+	os.Setenv("DEV_CLIENT_TEST_TOKEN", "env-token")
+	defer os.Unsetenv("DEV_CLIENT_TEST_TOKEN")
+
+	tests := []struct {
+		name     string
+		provider TokenProvider
+		expected string
+	}{
+		{"static", StaticToken("static-token"), "static-token"},
+		{"env", &EnvTokenProvider{Name: "DEV_CLIENT_TEST_TOKEN"}, "env-token"},
+		{"dotenv", &DotenvTokenProvider{Path: write("test.env", "OTHER=1\nDEV_API_KEY=dotenv-token\n")}, "dotenv-token"},
+		{"config", &ConfigFileTokenProvider{
+			Path:  write("config.json", `{"profiles": {"work": {"token": "config-token"}}}`),
+			Field: "profiles.work.token",
+		}, "config-token"},
+		{"file", &FileTokenProvider{Path: write("token", "file-token\n")}, "file-token"},
+		{"command", &CommandTokenProvider{Command: "sh", Args: []string{"-c", "echo protocol=https; echo password=command-token"}}, "command-token"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token, err := tt.provider.Token(ctx)
+			if err != nil {
+				t.Fatalf("Error getting token: %s", err.Error())
+			}
+
+			if token != tt.expected {
+				t.Errorf("Expected '%s', got '%s'", tt.expected, token)
+			}
+		})
+	}
+
+	if _, err := (&ConfigFileTokenProvider{Path: write("empty.json", `{}`)}).Token(ctx); err == nil {
+		t.Error("Expected an error for a config without a token")
+	}
+
+	if _, err := (&EnvTokenProvider{Name: "DEV_CLIENT_TEST_MISSING"}).Token(ctx); err == nil {
+		t.Error("Expected an error for an unset variable")
+	}
+}
+
+func TestClientUsesRotatedToken(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token")
+	if err := ioutil.WriteFile(path, []byte("first"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	var keys []string
+
+	c := newMockClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get("api-key"))
+		json.NewEncoder(w).Encode(User{ID: 1})
+	}))
+	c.TokenProvider = &FileTokenProvider{Path: path}
+
+	if _, err := c.GetAuthenticatedUser(); err != nil {
+		t.Fatalf("Error getting user: %s", err.Error())
+	}
+
+	// a different size guarantees the change is seen even on file systems
+	// with coarse modification times
+	if err := ioutil.WriteFile(path, []byte("second-token"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.GetAuthenticatedUser(); err != nil {
+		t.Fatalf("Error getting user: %s", err.Error())
+	}
+
+	if !equalStrings(keys, []string{"first", "second-token"}) {
+		t.Errorf("Expected the rotated token to be used, got %v", keys)
+	}
+}
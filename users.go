@@ -1,7 +1,6 @@
 package dev
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/google/go-querystring/query"
@@ -54,7 +53,7 @@ type ReadingListQueryParams struct {
 func (c *Client) GetUserByID(userID string) (*User, error) {
 	path := fmt.Sprintf("/users/%s", userID)
 
-	req, err := c.NewRequest(context.Background(), "GET", path, nil)
+	req, err := c.NewRequest(c.requestContext("GetUserByID"), "GET", path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -78,7 +77,7 @@ func (c *Client) GetUserByUsername(q UserQueryParams) (*User, error) {
 
 	path := fmt.Sprintf("/users/by_username?%s", query.Encode())
 
-	req, err := c.NewRequest(context.Background(), "GET", path, nil)
+	req, err := c.NewRequest(c.requestContext("GetUserByUsername"), "GET", path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -97,7 +96,7 @@ func (c *Client) GetUserByUsername(q UserQueryParams) (*User, error) {
 func (c *Client) GetAuthenticatedUser() (*User, error) {
 	path := "/users/me"
 
-	req, err := c.NewRequest(context.Background(), "GET", path, nil)
+	req, err := c.NewRequest(c.requestContext("GetAuthenticatedUser"), "GET", path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -123,7 +122,7 @@ func (c *Client) GetUserReadingList(q ReadingListQueryParams) ([]ReadingList, er
 
 	path := fmt.Sprintf("/readinglist?%s", query.Encode())
 
-	req, err := c.NewRequest(context.Background(), "GET", path, nil)
+	req, err := c.NewRequest(c.requestContext("GetUserReadingList"), "GET", path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -146,7 +145,7 @@ func (c *Client) GetUserFollowers(q UserQueryParams) ([]User, error) {
 
 	path := fmt.Sprintf("/followers/users?%s", query.Encode())
 
-	req, err := c.NewRequest(context.Background(), "GET", path, nil)
+	req, err := c.NewRequest(c.requestContext("GetUserFollowers"), "GET", path, nil)
 	if err != nil {
 		return nil, err
 	}
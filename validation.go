@@ -0,0 +1,177 @@
+package dev
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const (
+	maxArticleTags = 4
+	maxListingTags = 8
+	maxTagLength   = 30
+)
+
+var tagRegexp = regexp.MustCompile(`^[[:alnum:]]+$`)
+
+// FieldError describes a single field that failed validation
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+func (f FieldError) Error() string {
+	return fmt.Sprintf("%s: %s", f.Field, f.Message)
+}
+
+// ValidationErrors is returned when a payload fails client-side
+// validation, it holds every field error found
+type ValidationErrors []FieldError
+
+func (v ValidationErrors) Error() string {
+	msgs := make([]string, len(v))
+	for i, f := range v {
+		msgs[i] = f.Error()
+	}
+
+	return "validation failed: " + strings.Join(msgs, "; ")
+}
+
+func (v *ValidationErrors) add(field, format string, args ...interface{}) {
+	*v = append(*v, FieldError{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+func (v ValidationErrors) err() error {
+	if len(v) == 0 {
+		return nil
+	}
+
+	return v
+}
+
+// Validate checks the article against the rules DEV enforces when creating
+// an article. It returns ValidationErrors listing every invalid field
+func (a ArticleBodySchema) Validate() error {
+	return a.validate(true)
+}
+
+// validate skips the title requirement on updates since
+// an existing article already has one
+func (a ArticleBodySchema) validate(requireTitle bool) error {
+	var errs ValidationErrors
+
+	article := a.Article
+
+	// DEV accepts a title in the front matter in place of the title field
+	frontMatterTitle, _ := FrontMatterField(article.BodyMarkdown, "title")
+
+	if requireTitle && strings.TrimSpace(article.Title) == "" && frontMatterTitle == "" {
+		errs.add("title", "is required")
+	}
+
+	validateTags(&errs, "tags", article.Tags, maxArticleTags)
+
+	if article.CanonicalURL != "" && !isAbsoluteURL(article.CanonicalURL) {
+		errs.add("canonical_url", "'%s' is not an absolute http(s) url", article.CanonicalURL)
+	}
+
+	if article.MainImage != "" && !isAbsoluteURL(article.MainImage) {
+		errs.add("main_image", "'%s' is not an absolute http(s) url", article.MainImage)
+	}
+
+	return errs.err()
+}
+
+// Validate checks the listing against the rules DEV enforces when creating
+// a listing. Payloads that only carry an action are not validated
+func (l ListingBodySchema) Validate() error {
+	var errs ValidationErrors
+
+	listing := l.Listing
+
+	if listing.Action != "" {
+		switch listing.Action {
+		case Bump, Publish, Unpublish:
+		default:
+			errs.add("action", "'%s' is not a valid action", listing.Action)
+		}
+
+		return errs.err()
+	}
+
+	if strings.TrimSpace(listing.Title) == "" {
+		errs.add("title", "is required")
+	}
+
+	if strings.TrimSpace(listing.BodyMarkdown) == "" {
+		errs.add("body_markdown", "is required")
+	}
+
+	if listing.Category == "" {
+		errs.add("category", "is required")
+	} else if !listing.Category.Valid() {
+		errs.add("category", "'%s' is not a valid listing category", listing.Category)
+	}
+
+	tags := listing.Tags
+	if len(tags) == 0 && listing.TagList != "" {
+		for _, tag := range strings.Split(listing.TagList, ",") {
+			tags = append(tags, strings.TrimSpace(tag))
+		}
+	}
+
+	validateTags(&errs, "tags", tags, maxListingTags)
+
+	if listing.ExpiresAt != "" {
+		expiresAt, err := parseExpiryDate(listing.ExpiresAt)
+		if err != nil {
+			errs.add("expires_at", "'%s' is not a valid date", listing.ExpiresAt)
+		} else if !expiresAt.After(time.Now()) {
+			errs.add("expires_at", "must be in the future")
+		}
+	}
+
+	return errs.err()
+}
+
+func validateTags(errs *ValidationErrors, field string, tags []string, max int) {
+	if len(tags) > max {
+		errs.add(field, "at most %d tags are allowed, got %d", max, len(tags))
+	}
+
+	seen := map[string]bool{}
+
+	for _, tag := range tags {
+		switch {
+		case tag == "":
+			errs.add(field, "tags cannot be empty")
+		case !tagRegexp.MatchString(tag):
+			errs.add(field, "'%s' must only contain letters and numbers", tag)
+		case len(tag) > maxTagLength:
+			errs.add(field, "'%s' is longer than %d characters", tag, maxTagLength)
+		case seen[strings.ToLower(tag)]:
+			errs.add(field, "'%s' is duplicated", tag)
+		}
+
+		seen[strings.ToLower(tag)] = true
+	}
+}
+
+func isAbsoluteURL(s string) bool {
+	u, err := url.Parse(s)
+	if err != nil {
+		return false
+	}
+
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+func parseExpiryDate(s string) (time.Time, error) {
+	if t, err := time.Parse("2006-01-02", s); err == nil {
+		return t, nil
+	}
+
+	return time.Parse(time.RFC3339, s)
+}
@@ -0,0 +1,79 @@
+package dev
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestArticleBodySchemaValidate(t *testing.T) {
+	t.Run("valid article", func(t *testing.T) {
+		payload := ArticleBodySchema{}
+		payload.Article.Title = "The crust of structs in Go"
+		payload.Article.Tags = []string{"golang", "go"}
+		payload.Article.CanonicalURL = "https://example.com/structs"
+
+		if err := payload.Validate(); err != nil {
+			t.Errorf("Expected article to be valid, got: %s", err.Error())
+		}
+	})
+
+	t.Run("front matter title", func(t *testing.T) {
+		payload := ArticleBodySchema{}
+		payload.Article.BodyMarkdown = "---\ntitle: Hello\npublished: false\n---\n\nBody"
+
+		if err := payload.Validate(); err != nil {
+			t.Errorf("Expected front matter title to be accepted, got: %s", err.Error())
+		}
+	})
+
+	t.Run("invalid article", func(t *testing.T) {
+		payload := ArticleBodySchema{}
+		payload.Article.Tags = []string{"a", "b", "c", "d", "web-dev"}
+		payload.Article.CanonicalURL = "example.com/structs"
+
+		err := payload.Validate()
+
+		var errs ValidationErrors
+		if !errors.As(err, &errs) {
+			t.Fatalf("Expected ValidationErrors, got %v", err)
+		}
+
+		fields := map[string]int{}
+		for _, f := range errs {
+			fields[f.Field]++
+		}
+
+		if fields["title"] != 1 || fields["tags"] != 2 || fields["canonical_url"] != 1 {
+			t.Errorf("Unexpected field errors: %v", errs)
+		}
+	})
+}
+
+func TestListingBodySchemaValidate(t *testing.T) {
+	payload := ListingBodySchema{}
+	payload.Listing.Title = "ACME Conference"
+	payload.Listing.BodyMarkdown = "Awesome conference, come join us!"
+	payload.Listing.Category = ListingCategoryCfp
+	payload.Listing.TagList = "events, go"
+	payload.Listing.ExpiresAt = time.Now().AddDate(0, 0, 7).Format("2006-01-02")
+
+	if err := payload.Validate(); err != nil {
+		t.Errorf("Expected listing to be valid, got: %s", err.Error())
+	}
+
+	payload.Listing.Category = ListingCategory("garage")
+	payload.Listing.ExpiresAt = "2001-01-01"
+
+	var errs ValidationErrors
+	if err := payload.Validate(); !errors.As(err, &errs) || len(errs) != 2 {
+		t.Errorf("Expected category and expiry errors, got %v", err)
+	}
+
+	action := ListingBodySchema{}
+	action.Listing.Action = Bump
+
+	if err := action.Validate(); err != nil {
+		t.Errorf("Expected action payload to be valid, got: %s", err.Error())
+	}
+}
@@ -1,7 +1,6 @@
 package dev
 
 import (
-	"context"
 	"fmt"
 )
 
@@ -29,7 +28,7 @@ func (c *Client) GetWebhooks() ([]Webhook, error) {
 
 	path := "/webhooks"
 
-	req, err := c.NewRequest(context.Background(), "GET", path, nil)
+	req, err := c.NewRequest(c.requestContext("GetWebhooks"), "GET", path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -45,7 +44,7 @@ func (c *Client) GetWebhooks() ([]Webhook, error) {
 func (c *Client) CreateWebhook(payload WebhookBodySchema) (*Webhook, error) {
 	path := "/webhooks"
 
-	req, err := c.NewRequest(context.Background(), "POST", path, payload)
+	req, err := c.NewRequest(c.requestContext("CreateWebhook"), "POST", path, payload)
 	if err != nil {
 		return nil, err
 	}
@@ -63,7 +62,7 @@ func (c *Client) CreateWebhook(payload WebhookBodySchema) (*Webhook, error) {
 func (c *Client) GetWebhookByID(webhookID string) (*Webhook, error) {
 	path := fmt.Sprintf("/webhooks/%s", webhookID)
 
-	req, err := c.NewRequest(context.Background(), "GET", path, nil)
+	req, err := c.NewRequest(c.requestContext("GetWebhookByID"), "GET", path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -81,7 +80,7 @@ func (c *Client) GetWebhookByID(webhookID string) (*Webhook, error) {
 func (c *Client) DeleteWebhook(webhookID string) error {
 	path := fmt.Sprintf("/webhooks/%s", webhookID)
 
-	req, err := c.NewRequest(context.Background(), "DELETE", path, nil)
+	req, err := c.NewRequest(c.requestContext("DeleteWebhook"), "DELETE", path, nil)
 	if err != nil {
 		return err
 	}